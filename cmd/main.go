@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/madmuzz05/be-enyoblos/package/logger"
 	"github.com/madmuzz05/be-enyoblos/package/redisdb"
 	"github.com/madmuzz05/be-enyoblos/service/routes"
+	"github.com/madmuzz05/be-enyoblos/service/scheduler"
 	"github.com/rs/zerolog/log"
 )
 
@@ -52,7 +54,7 @@ func main() {
 	app.Use(logger.NewLogger())
 
 	// Load routes
-	app = routes.InitRoutes(app, db, redisDb)
+	app, electionUse := routes.InitRoutes(app, db, redisDb)
 
 	app.Use(func(c fiber.Ctx) error {
 		for _, routes := range app.Stack() {
@@ -69,6 +71,11 @@ func main() {
 		return helper.SendResponse(c, fiber.StatusNotFound, "Endpoint not found", nil)
 	})
 
+	// Scheduler buka/tutup election otomatis, aman dijalankan di banyak replica (redis lock)
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	go scheduler.InitElectionScheduler(app, electionUse, redisDb, config.AppConfig.SchedulerInterval).Start(schedulerCtx)
+
 	// Ambil port dari env
 	port := strconv.Itoa(config.AppConfig.Port)
 	if port == "" {
@@ -11,21 +11,23 @@ var AppConfig Config
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Port             int    `mapstructure:"APP_PORT"`
-	JwtSecret        string `mapstructure:"JWT_SECRET"`
-	JwtKey           string `mapstructure:"JWT_KEY"`
-	JwtExpiresIn     int64  `mapstructure:"JWT_EXPIRES_IN"`
-	DatabaseHost     string `mapstructure:"DB_HOST"`
-	DatabasePort     string `mapstructure:"DB_PORT"`
-	DatabaseUsername string `mapstructure:"DB_USERNAME"`
-	DatabasePassword string `mapstructure:"DB_PASSWORD"`
-	DatabaseName     string `mapstructure:"DB_DATABASE"`
-	DatabaseSSL      string `mapstructure:"DB_SSL"`
-	RateLimitMax     int    `mapstructure:"RATE_LIMIT_MAX"`
-	RateLimitWindow  int    `mapstructure:"RATE_LIMIT_WINDOW"`
-	RedisHost        string `mapstructure:"REDIS_HOST"`
-	RedisPort        string `mapstructure:"REDIS_PORT"`
-	RedisPassword    string `mapstructure:"REDIS_PASSWORD"`
+	Port              int    `mapstructure:"APP_PORT"`
+	JwtSecret         string `mapstructure:"JWT_SECRET"`
+	JwtKey            string `mapstructure:"JWT_KEY"`
+	JwtExpiresIn      int64  `mapstructure:"JWT_EXPIRES_IN"`
+	DatabaseHost      string `mapstructure:"DB_HOST"`
+	DatabasePort      string `mapstructure:"DB_PORT"`
+	DatabaseUsername  string `mapstructure:"DB_USERNAME"`
+	DatabasePassword  string `mapstructure:"DB_PASSWORD"`
+	DatabaseName      string `mapstructure:"DB_DATABASE"`
+	DatabaseSSL       string `mapstructure:"DB_SSL"`
+	RateLimitMax      int    `mapstructure:"RATE_LIMIT_MAX"`
+	RateLimitWindow   int    `mapstructure:"RATE_LIMIT_WINDOW"`
+	RedisHost         string `mapstructure:"REDIS_HOST"`
+	RedisPort         string `mapstructure:"REDIS_PORT"`
+	RedisPassword     string `mapstructure:"REDIS_PASSWORD"`
+	SchedulerInterval int    `mapstructure:"SCHEDULER_INTERVAL"`      // detik, default 30
+	CertificateKey    string `mapstructure:"CERTIFICATE_SIGNING_KEY"` // seed Ed25519 32 byte (base64)
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -0,0 +1,62 @@
+// Package ballotorder membuat urutan acak yang deterministik untuk pilihan pada surat suara.
+// Urutan diturunkan dari seed (misalnya id election, pemilih, dan contest) sehingga pemilih yang sama
+// selalu melihat urutan yang sama, sementara pemilih lain melihat urutan berbeda.
+package ballotorder
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+// Seed - Gabungkan identitas menjadi seed, urutan parts ikut menentukan hasil
+func Seed(parts ...int) string {
+	values := make([]string, len(parts))
+	for i, part := range parts {
+		values[i] = strconv.Itoa(part)
+	}
+	return strings.Join(values, ":")
+}
+
+// Permutation - Urutan index 0..n-1 hasil Fisher-Yates dengan sumber acak SHA-256(seed || counter)
+func Permutation(n int, seed string) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	stream := &stream{seed: seed}
+	for i := n - 1; i > 0; i-- {
+		j := stream.intn(i + 1)
+		order[i], order[j] = order[j], order[i]
+	}
+	return order
+}
+
+// stream - deret bilangan acak deterministik dari hash seed dan counter
+type stream struct {
+	seed    string
+	counter uint64
+}
+
+func (s *stream) next() uint64 {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, s.counter)
+	s.counter++
+
+	sum := sha256.Sum256(append([]byte(s.seed+"|"), buf...))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// intn - bilangan 0..n-1 tanpa bias modulo, nilai di luar kelipatan n ditolak lalu diambil ulang
+func (s *stream) intn(n int) int {
+	bound := uint64(n)
+	limit := ^uint64(0) - (^uint64(0) % bound)
+	for {
+		value := s.next()
+		if value < limit {
+			return int(value % bound)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package ballotorder
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestPermutationIsPermutation(t *testing.T) {
+	for n := 0; n <= 12; n++ {
+		order := Permutation(n, Seed(7, n))
+		if len(order) != n {
+			t.Fatalf("n=%d: len = %d", n, len(order))
+		}
+		sorted := slices.Sorted(slices.Values(order))
+		for i, value := range sorted {
+			if value != i {
+				t.Fatalf("n=%d: bukan permutasi %v", n, order)
+			}
+		}
+	}
+}
+
+func TestPermutationDeterministic(t *testing.T) {
+	first := Permutation(10, Seed(1, 42, 3))
+	second := Permutation(10, Seed(1, 42, 3))
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("seed sama menghasilkan urutan berbeda: %v vs %v", first, second)
+	}
+}
+
+func TestPermutationStable(t *testing.T) {
+	// urutan harus sama di setiap versi supaya pemilih tidak melihat surat suara berubah
+	got := Permutation(5, Seed(1, 2, 3))
+	want := []int{0, 3, 1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Permutation = %v, want %v", got, want)
+	}
+}
+
+func TestPermutationVariesBySeed(t *testing.T) {
+	base := Permutation(8, Seed(1, 1, 1))
+	different := 0
+	for voter := 2; voter <= 20; voter++ {
+		if !reflect.DeepEqual(base, Permutation(8, Seed(1, voter, 1))) {
+			different++
+		}
+	}
+	if different == 0 {
+		t.Fatal("semua pemilih mendapat urutan yang sama")
+	}
+}
+
+func TestPermutationSpread(t *testing.T) {
+	// setiap index harus pernah muncul di posisi pertama untuk banyak seed
+	const n = 4
+	first := make([]int, n)
+	for voter := 0; voter < 4000; voter++ {
+		first[Permutation(n, Seed(9, voter))[0]]++
+	}
+	for i, count := range first {
+		if count < 800 || count > 1200 {
+			t.Fatalf("index %d di posisi pertama %d kali dari 4000, distribusi timpang %v", i, count, first)
+		}
+	}
+}
+
+func TestSeed(t *testing.T) {
+	if Seed(1, 23) == Seed(12, 3) {
+		t.Fatal("seed dari identitas berbeda tidak boleh sama")
+	}
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,7 +11,7 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	migratePostgres "github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 
 	_ "github.com/golang-migrate/migrate/v4/source/file" // WAJIB
 	syserror "github.com/madmuzz05/be-enyoblos/package/error"
@@ -22,10 +23,17 @@ type MainDB struct {
 }
 
 type DBWithCtx struct {
-	DB  *sqlx.DB
+	DB  Executor
 	Ctx context.Context
 }
 
+// Executor - kontrak query yang dipenuhi oleh *sqlx.DB maupun *sqlx.Tx
+type Executor interface {
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
 func Connect(host, user, password, dbname, port, sslmode string) (*MainDB, error) {
 	dsn := fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s channel_binding=require",
@@ -107,31 +115,54 @@ func TxCreate(c fiber.Ctx, db *sqlx.DB) (*sqlx.Tx, syserror.SysError) {
 	return tx, nil
 }
 
-func TxSubmitTerr(c fiber.Ctx, sysError syserror.SysError) {
+// UseTx mengembalikan transaction aktif dari TxCreate jika ada,
+// selain itu kembali ke koneksi utama
+func UseTx(c fiber.Ctx, db *sqlx.DB) Executor {
+	if tx, ok := c.Locals("tx").(*sqlx.Tx); ok && tx != nil {
+		return tx
+	}
+	return db
+}
+
+// TxSubmitTerr menutup transaction dari TxCreate: rollback jika sysError terisi, selain itu commit.
+// Mengembalikan sysError, atau error 500 jika commit gagal sehingga pemanggil yang menampung
+// hasilnya di dalam defer tidak mengirim response sukses untuk data yang tidak tersimpan
+func TxSubmitTerr(c fiber.Ctx, sysError syserror.SysError) syserror.SysError {
 	txInterface := c.Locals("tx")
 	if txInterface == nil {
-		return
+		return sysError
 	}
 
 	tx, ok := txInterface.(*sqlx.Tx)
 	if !ok {
 		log.Error().Msg("invalid tx type")
-		return
+		return sysError
 	}
 
+	// transaction sudah selesai, jangan dipakai lagi oleh query berikutnya
+	c.Locals("tx", nil)
+
 	if sysError != nil {
 		if err := tx.Rollback(); err != nil {
 			log.Error().Err(err).Msg("failed rollback transaction")
 		} else {
 			log.Info().Msg("transaction rolled back")
 		}
-	} else {
-		if err := tx.Commit(); err != nil {
-			log.Error().Err(err).Msg("failed commit transaction")
-		} else {
-			log.Info().Msg("transaction committed")
-		}
+		return sysError
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed commit transaction")
+		return syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan transaksi")
 	}
+	log.Info().Msg("transaction committed")
+	return nil
+}
+
+// IsUniqueViolation - Cek apakah error berasal dari pelanggaran unique constraint postgres
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
 }
 
 func RunMigrationsPostgres(db *sqlx.DB) {
@@ -0,0 +1,14 @@
+-- satu organization bisa memiliki banyak anggota (pemilih)
+ALTER TABLE public.users
+DROP CONSTRAINT IF EXISTS users_organization_id_key;
+
+CREATE TABLE IF NOT EXISTS ballots (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id),
+    candidate_id INT NOT NULL REFERENCES candidates(id),
+    receipt_code VARCHAR(32) NOT NULL UNIQUE,
+    cast_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- satu user hanya bisa memberikan satu suara per election
+    CONSTRAINT ballots_election_user_key UNIQUE (election_id, user_id)
+);
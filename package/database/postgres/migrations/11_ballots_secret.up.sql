@@ -0,0 +1,26 @@
+-- buku partisipasi: hanya mencatat bahwa user X sudah memilih di election Y,
+-- tanpa id berurutan maupun waktu supaya tidak bisa dicocokkan dengan isi ballot
+CREATE TABLE IF NOT EXISTS election_participations (
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id),
+    PRIMARY KEY (election_id, user_id)
+);
+
+INSERT INTO election_participations (election_id, user_id)
+SELECT election_id, user_id FROM ballots
+ON CONFLICT DO NOTHING;
+
+-- ballots tidak lagi memiliki referensi ke users
+ALTER TABLE ballots DROP CONSTRAINT IF EXISTS ballots_election_user_key;
+ALTER TABLE ballots DROP COLUMN IF EXISTS user_id;
+ALTER TABLE ballots DROP COLUMN IF EXISTS cast_at;
+
+-- id acak, urutan insert tidak bisa dipakai untuk mencocokkan dengan partisipasi
+ALTER TABLE ballots DROP CONSTRAINT IF EXISTS ballots_pkey;
+ALTER TABLE ballots ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE ballots ALTER COLUMN id TYPE UUID USING gen_random_uuid();
+ALTER TABLE ballots ALTER COLUMN id SET DEFAULT gen_random_uuid();
+ALTER TABLE ballots ADD PRIMARY KEY (id);
+DROP SEQUENCE IF EXISTS ballots_id_seq;
+
+CREATE INDEX IF NOT EXISTS idx_ballots_election_id ON ballots(election_id);
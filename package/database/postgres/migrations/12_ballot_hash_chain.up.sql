@@ -0,0 +1,49 @@
+-- isi suara disimpan sebagai JSON kanonik supaya byte yang di-hash sama dengan yang dihitung
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS content TEXT;
+UPDATE ballots SET content = '{"candidate_id":' || candidate_id || '}' WHERE content IS NULL;
+ALTER TABLE ballots ALTER COLUMN content SET NOT NULL;
+ALTER TABLE ballots DROP COLUMN IF EXISTS candidate_id;
+
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS sequence INT;
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS prev_hash VARCHAR(64);
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS hash VARCHAR(64);
+
+-- bangun rantai untuk ballot yang sudah ada, format hash sama dengan entity.Ballot.ComputeHash
+WITH RECURSIVE ordered AS (
+    SELECT id, election_id, receipt_code, content,
+           ROW_NUMBER() OVER (PARTITION BY election_id ORDER BY receipt_code) AS seq
+    FROM ballots
+),
+chain AS (
+    SELECT o.id, o.election_id, o.seq, REPEAT('0', 64) AS prev_hash,
+           ENCODE(SHA256(CONVERT_TO(REPEAT('0', 64) || '|' || o.election_id || '|' || o.seq || '|' || o.receipt_code || '|' || o.content, 'UTF8')), 'hex') AS hash
+    FROM ordered o
+    WHERE o.seq = 1
+    UNION ALL
+    SELECT o.id, o.election_id, o.seq, c.hash,
+           ENCODE(SHA256(CONVERT_TO(c.hash || '|' || o.election_id || '|' || o.seq || '|' || o.receipt_code || '|' || o.content, 'UTF8')), 'hex')
+    FROM ordered o
+    JOIN chain c ON c.election_id = o.election_id AND o.seq = c.seq + 1
+)
+UPDATE ballots b
+SET sequence = chain.seq, prev_hash = chain.prev_hash, hash = chain.hash
+FROM chain
+WHERE b.id = chain.id;
+
+ALTER TABLE ballots ALTER COLUMN sequence SET NOT NULL;
+ALTER TABLE ballots ALTER COLUMN prev_hash SET NOT NULL;
+ALTER TABLE ballots ALTER COLUMN hash SET NOT NULL;
+ALTER TABLE ballots ADD CONSTRAINT ballots_election_sequence_key UNIQUE (election_id, sequence);
+
+-- kepala rantai per election, di-lock saat append supaya urutan tidak balapan
+CREATE TABLE IF NOT EXISTS ballot_chain_heads (
+    election_id INT NOT NULL PRIMARY KEY REFERENCES elections(id) ON DELETE CASCADE,
+    sequence INT NOT NULL DEFAULT 0,
+    hash VARCHAR(64) NOT NULL
+);
+
+INSERT INTO ballot_chain_heads (election_id, sequence, hash)
+SELECT DISTINCT ON (election_id) election_id, sequence, hash
+FROM ballots
+ORDER BY election_id, sequence DESC
+ON CONFLICT (election_id) DO NOTHING;
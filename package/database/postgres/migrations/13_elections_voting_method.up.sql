@@ -0,0 +1,2 @@
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS voting_method VARCHAR(20) NOT NULL DEFAULT 'plurality'
+    CHECK (voting_method IN ('plurality', 'approval', 'irv', 'borda'));
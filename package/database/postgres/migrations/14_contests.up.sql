@@ -0,0 +1,28 @@
+-- satu election bisa berisi beberapa contest (jabatan), masing-masing dengan kursi dan metode sendiri
+CREATE TABLE IF NOT EXISTS contests (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    seats INT NOT NULL DEFAULT 1 CHECK (seats > 0),
+    voting_method VARCHAR(20) NOT NULL DEFAULT 'plurality'
+        CHECK (voting_method IN ('plurality', 'approval', 'irv', 'borda')),
+    position INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS contests_election_id_idx ON contests (election_id);
+
+-- setiap election yang sudah ada mendapat satu contest default
+INSERT INTO contests (election_id, name, seats, voting_method, position)
+SELECT e.id, e.title, 1, e.voting_method, 1
+FROM elections e
+WHERE NOT EXISTS (SELECT 1 FROM contests c WHERE c.election_id = e.id);
+
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS contest_id INT REFERENCES contests(id) ON DELETE CASCADE;
+UPDATE candidates ca SET contest_id = co.id FROM contests co WHERE co.election_id = ca.election_id AND ca.contest_id IS NULL;
+ALTER TABLE candidates ALTER COLUMN contest_id SET NOT NULL;
+
+-- nomor urut sekarang unik per contest
+ALTER TABLE candidates DROP CONSTRAINT IF EXISTS candidates_election_ballot_number_key;
+ALTER TABLE candidates ADD CONSTRAINT candidates_contest_ballot_number_key UNIQUE (contest_id, ballot_number) DEFERRABLE INITIALLY IMMEDIATE;
@@ -0,0 +1,41 @@
+-- nomor anggota untuk pencocokan DPT dari CSV, unik dalam satu organization
+ALTER TABLE users ADD COLUMN IF NOT EXISTS member_number VARCHAR(50);
+CREATE UNIQUE INDEX IF NOT EXISTS users_organization_member_number_key
+    ON users (organization_id, member_number) WHERE member_number IS NOT NULL;
+
+-- daftar pemilih tetap (DPT) per election, nama/email/nomor anggota disalin saat ditetapkan
+CREATE TABLE IF NOT EXISTS voter_rolls (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id),
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    member_number VARCHAR(50),
+    source VARCHAR(20) NOT NULL CHECK (source IN ('member', 'filter', 'csv')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT voter_rolls_election_user_key UNIQUE (election_id, user_id)
+);
+
+-- election yang sudah berjalan tetap memakai aturan lama: seluruh anggota organization
+INSERT INTO voter_rolls (election_id, user_id, name, email, member_number, source)
+SELECT e.id, u.id, u.name, u.email, u.member_number, 'member'
+FROM elections e
+JOIN users u ON u.organization_id = e.organization_id
+WHERE e.status <> 'draft'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO voter_rolls (election_id, user_id, name, email, member_number, source)
+SELECT p.election_id, u.id, u.name, u.email, u.member_number, 'member'
+FROM election_participations p
+JOIN users u ON u.id = p.user_id
+ON CONFLICT DO NOTHING;
+
+-- buku partisipasi sekarang merujuk entri DPT, bukan user
+ALTER TABLE election_participations ADD COLUMN IF NOT EXISTS voter_roll_id INT REFERENCES voter_rolls(id);
+UPDATE election_participations p SET voter_roll_id = v.id
+FROM voter_rolls v
+WHERE v.election_id = p.election_id AND v.user_id = p.user_id AND p.voter_roll_id IS NULL;
+ALTER TABLE election_participations ALTER COLUMN voter_roll_id SET NOT NULL;
+ALTER TABLE election_participations DROP CONSTRAINT IF EXISTS election_participations_pkey;
+ALTER TABLE election_participations ADD PRIMARY KEY (election_id, voter_roll_id);
+ALTER TABLE election_participations DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,20 @@
+-- pemilih DPT tidak harus punya akun, identitasnya cukup email dan/atau nomor anggota
+ALTER TABLE voter_rolls ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE voter_rolls ALTER COLUMN email SET DEFAULT '';
+CREATE UNIQUE INDEX IF NOT EXISTS voter_rolls_election_email_key
+    ON voter_rolls (election_id, LOWER(email)) WHERE email <> '';
+CREATE UNIQUE INDEX IF NOT EXISTS voter_rolls_election_member_number_key
+    ON voter_rolls (election_id, member_number) WHERE member_number IS NOT NULL;
+
+-- kode voting sekali pakai per entri DPT, hanya hash yang disimpan
+CREATE TABLE IF NOT EXISTS voting_codes (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    voter_roll_id INT NOT NULL UNIQUE REFERENCES voter_rolls(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    used_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS voting_codes_election_id_idx ON voting_codes (election_id);
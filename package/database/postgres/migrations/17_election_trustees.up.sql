@@ -0,0 +1,26 @@
+-- trustee pemegang share private key election, share dienkripsi ke public key masing-masing trustee
+CREATE TABLE IF NOT EXISTS election_trustees (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id),
+    position INT NOT NULL,
+    public_key TEXT,
+    encrypted_share TEXT,
+    share_hash VARCHAR(64),
+    share TEXT,
+    submitted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT election_trustees_election_user_key UNIQUE (election_id, user_id),
+    CONSTRAINT election_trustees_election_position_key UNIQUE (election_id, position)
+);
+
+-- public key election hasil key ceremony, private key hanya diisi setelah direkonstruksi dari share trustee
+CREATE TABLE IF NOT EXISTS election_keys (
+    election_id INT NOT NULL PRIMARY KEY REFERENCES elections(id) ON DELETE CASCADE,
+    public_key TEXT NOT NULL,
+    threshold INT NOT NULL CHECK (threshold > 0),
+    trustee_count INT NOT NULL CHECK (trustee_count >= threshold),
+    private_key TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    decrypted_at TIMESTAMP
+);
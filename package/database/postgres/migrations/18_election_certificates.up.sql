@@ -0,0 +1,14 @@
+-- berita acara hasil election yang ditandatangani server (Ed25519), satu per election.
+-- document dan text disimpan apa adanya karena tanda tangan berlaku atas byte yang persis sama
+CREATE TABLE IF NOT EXISTS election_certificates (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL UNIQUE REFERENCES elections(id) ON DELETE CASCADE,
+    document TEXT NOT NULL,
+    text TEXT NOT NULL,
+    signature TEXT NOT NULL UNIQUE,
+    algorithm VARCHAR(20) NOT NULL,
+    public_key TEXT NOT NULL,
+    key_id VARCHAR(16) NOT NULL,
+    issued_by INT NOT NULL REFERENCES users(id),
+    issued_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,18 @@
+-- referendum: election berisi pertanyaan (contest kind question) dengan pilihan jawaban
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS type VARCHAR(20) NOT NULL DEFAULT 'election'
+    CHECK (type IN ('election', 'referendum'));
+
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'candidate'
+    CHECK (kind IN ('candidate', 'question'));
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS answer_type VARCHAR(20)
+    CHECK (answer_type IN ('yes_no', 'yes_no_abstain', 'multiple_choice'));
+-- ambang berbentuk pecahan a/b, quorum terhadap jumlah pemilih DPT, pass_threshold terhadap suara setuju + menolak
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS quorum VARCHAR(20) CHECK (quorum ~ '^[0-9]+/[0-9]+$');
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS pass_threshold VARCHAR(20) CHECK (pass_threshold ~ '^[0-9]+/[0-9]+$');
+ALTER TABLE contests DROP CONSTRAINT IF EXISTS contests_question_answer_type_check;
+ALTER TABLE contests ADD CONSTRAINT contests_question_answer_type_check
+    CHECK ((kind = 'question') = (answer_type IS NOT NULL));
+
+-- pilihan jawaban pertanyaan disimpan sebagai candidate; answer menandai jawaban ya/tidak/abstain
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS answer VARCHAR(10) CHECK (answer IN ('yes', 'no', 'abstain'));
@@ -0,0 +1,6 @@
+-- bobot suara per pemilih (koperasi/asosiasi), default 1 untuk satu orang satu suara
+ALTER TABLE voter_rolls ADD COLUMN IF NOT EXISTS weight INT NOT NULL DEFAULT 1 CHECK (weight > 0);
+
+-- bobot ikut disimpan pada ballot dan di-hash (hanya jika bukan 1) supaya hasil bisa dihitung ulang dari rantai.
+-- Ballot lama otomatis berbobot 1 sehingga hash-nya tetap valid
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS weight INT NOT NULL DEFAULT 1 CHECK (weight > 0);
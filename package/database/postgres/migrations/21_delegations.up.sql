@@ -0,0 +1,32 @@
+-- batas jumlah kuasa yang boleh dipegang satu pemilih, 0 berarti delegasi tidak diizinkan
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS proxy_limit INT NOT NULL DEFAULT 0 CHECK (proxy_limit >= 0);
+
+-- pemilih DPT (delegator) menguasakan suaranya kepada pemilih DPT lain (proxy) pada satu election
+CREATE TABLE IF NOT EXISTS election_delegations (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    delegator_voter_roll_id INT NOT NULL REFERENCES voter_rolls(id) ON DELETE CASCADE,
+    proxy_voter_roll_id INT NOT NULL REFERENCES voter_rolls(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'revoked', 'used')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+    used_at TIMESTAMP,
+    CHECK (delegator_voter_roll_id <> proxy_voter_roll_id)
+);
+
+-- satu pemilih hanya boleh memiliki satu delegasi yang belum dicabut
+CREATE UNIQUE INDEX IF NOT EXISTS election_delegations_delegator_key
+    ON election_delegations (election_id, delegator_voter_roll_id) WHERE status <> 'revoked';
+CREATE INDEX IF NOT EXISTS election_delegations_proxy_idx ON election_delegations (election_id, proxy_voter_roll_id);
+
+-- jejak audit delegasi, hanya ditambah dan tidak pernah diubah
+CREATE TABLE IF NOT EXISTS election_delegation_events (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    delegation_id INT NOT NULL REFERENCES election_delegations(id) ON DELETE CASCADE,
+    event VARCHAR(20) NOT NULL CHECK (event IN ('created', 'revoked', 'used')),
+    actor_user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS election_delegation_events_election_id_idx ON election_delegation_events (election_id, id);
@@ -0,0 +1,12 @@
+-- pemungutan ulang: pemilih boleh mengganti suaranya selama election dibuka, hanya ballot terakhir yang dihitung
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS allow_revote BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- voter_tag adalah HMAC entri DPT (bukan id pemilih), hanya diisi pada election dengan pemungutan ulang
+-- supaya ballot terakhir pemilih bisa ditemukan tanpa tabel ballot mereferensi DPT
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS voter_tag VARCHAR(64);
+-- sequence ballot yang digantikan, ikut di-hash sehingga ballot yang digantikan tetap di rantai dan bisa diaudit
+ALTER TABLE ballots ADD COLUMN IF NOT EXISTS supersedes INT;
+
+CREATE INDEX IF NOT EXISTS ballots_election_voter_tag_idx ON ballots (election_id, voter_tag) WHERE voter_tag IS NOT NULL;
+-- setiap ballot hanya bisa digantikan satu kali
+CREATE UNIQUE INDEX IF NOT EXISTS ballots_election_supersedes_key ON ballots (election_id, supersedes) WHERE supersedes IS NOT NULL;
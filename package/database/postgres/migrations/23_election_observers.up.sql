@@ -0,0 +1,10 @@
+-- saksi: role observer yang berlaku untuk satu election saja, hanya bisa memantau tanpa mengubah data
+INSERT INTO roles (name, description)
+VALUES ('observer', 'Election observer role')
+ON CONFLICT (name) DO NOTHING;
+
+-- role dengan election_id berlaku untuk election tersebut saja, NULL berarti berlaku untuk seluruh organization
+ALTER TABLE users_has_roles ADD COLUMN IF NOT EXISTS election_id INT REFERENCES elections(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_has_roles_election_key ON users_has_roles (user_id, role_id, election_id) WHERE election_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS users_has_roles_election_idx ON users_has_roles (election_id) WHERE election_id IS NOT NULL;
@@ -0,0 +1,60 @@
+-- masa pendaftaran candidate, anggota organization hanya bisa mendaftar (atau didaftarkan) di antara kedua waktu ini
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS nomination_start_at TIMESTAMP;
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS nomination_end_at TIMESTAMP;
+
+-- pendaftaran candidate oleh anggota, baru masuk tabel candidates (dan surat suara) setelah disetujui panitia
+CREATE TABLE IF NOT EXISTS candidate_nominations (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    contest_id INT NOT NULL REFERENCES contests(id) ON DELETE CASCADE,
+    nominee_user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    nominated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    photo_url TEXT NOT NULL DEFAULT '',
+    vision TEXT NOT NULL DEFAULT '',
+    mission TEXT NOT NULL DEFAULT '',
+    running_mate_name VARCHAR(255),
+    running_mate_photo_url TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'withdrawn')),
+    review_reason TEXT,
+    reviewed_by INT REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP,
+    candidate_id INT REFERENCES candidates(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- satu anggota hanya boleh punya satu pendaftaran aktif per contest
+CREATE UNIQUE INDEX IF NOT EXISTS candidate_nominations_nominee_key
+    ON candidate_nominations (contest_id, nominee_user_id) WHERE status IN ('pending', 'approved');
+CREATE INDEX IF NOT EXISTS candidate_nominations_election_id_idx ON candidate_nominations (election_id, status);
+
+-- dokumen pendukung pendaftaran, isi file disimpan di database supaya ikut transaction dan backup
+CREATE TABLE IF NOT EXISTS nomination_documents (
+    id SERIAL NOT NULL PRIMARY KEY,
+    nomination_id INT NOT NULL REFERENCES candidate_nominations(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size INT NOT NULL CHECK (size > 0),
+    sha256 VARCHAR(64) NOT NULL,
+    content BYTEA NOT NULL,
+    uploaded_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS nomination_documents_nomination_id_idx ON nomination_documents (nomination_id);
+
+-- notifikasi in-app untuk user, misal perubahan status pendaftaran candidate
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL NOT NULL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    election_id INT REFERENCES elections(id) ON DELETE CASCADE,
+    reference_id INT,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (user_id, id);
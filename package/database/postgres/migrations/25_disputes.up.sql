@@ -0,0 +1,49 @@
+-- masa sanggahan setelah hasil dipublikasikan, dan penanda election yang sedang disengketakan (berita acara tertahan)
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS dispute_window_days INT NOT NULL DEFAULT 3 CHECK (dispute_window_days >= 0);
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS under_dispute BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- sanggahan pemilih atau candidate terhadap hasil election
+CREATE TABLE IF NOT EXISTS election_disputes (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    filed_by INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    filer_role VARCHAR(20) NOT NULL CHECK (filer_role IN ('voter', 'candidate')),
+    subject VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    resolution TEXT,
+    resolved_by INT REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS election_disputes_election_id_idx ON election_disputes (election_id, status);
+CREATE INDEX IF NOT EXISTS election_disputes_filed_by_idx ON election_disputes (filed_by);
+
+-- tanggapan panitia maupun pelapor atas sanggahan
+CREATE TABLE IF NOT EXISTS dispute_responses (
+    id SERIAL NOT NULL PRIMARY KEY,
+    dispute_id INT NOT NULL REFERENCES election_disputes(id) ON DELETE CASCADE,
+    author_user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    is_committee BOOLEAN NOT NULL DEFAULT FALSE,
+    message TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS dispute_responses_dispute_id_idx ON dispute_responses (dispute_id, id);
+
+-- lampiran bukti sanggahan, disimpan seperti dokumen pendaftaran candidate
+CREATE TABLE IF NOT EXISTS dispute_attachments (
+    id SERIAL NOT NULL PRIMARY KEY,
+    dispute_id INT NOT NULL REFERENCES election_disputes(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size INT NOT NULL CHECK (size > 0),
+    sha256 VARCHAR(64) NOT NULL,
+    content BYTEA NOT NULL,
+    uploaded_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS dispute_attachments_dispute_id_idx ON dispute_attachments (dispute_id);
@@ -0,0 +1,13 @@
+-- hirarki organization, dipakai aturan hak pilih "anggota organization turunan"
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS organizations_parent_id_idx ON organizations (parent_id);
+
+-- waktu pendaftaran user untuk aturan "terdaftar sebelum tanggal tertentu", user lama memakai waktu migrasi
+ALTER TABLE users ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- aturan hak pilih election, dievaluasi untuk mengisi DPT
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS eligibility_rules JSONB;
+
+-- entri DPT hasil evaluasi aturan ditandai supaya bisa dihapus saat aturan berubah tanpa menyentuh entri manual
+ALTER TABLE voter_rolls DROP CONSTRAINT IF EXISTS voter_rolls_source_check;
+ALTER TABLE voter_rolls ADD CONSTRAINT voter_rolls_source_check CHECK (source IN ('member', 'filter', 'csv', 'rules'));
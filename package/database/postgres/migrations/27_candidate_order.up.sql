@@ -0,0 +1,4 @@
+-- urutan candidate pada surat suara: fixed (urutan input), alphabetical, ballot_number, atau random per pemilih
+ALTER TABLE elections ADD COLUMN IF NOT EXISTS candidate_order VARCHAR(20) NOT NULL DEFAULT 'ballot_number';
+ALTER TABLE elections DROP CONSTRAINT IF EXISTS elections_candidate_order_check;
+ALTER TABLE elections ADD CONSTRAINT elections_candidate_order_check CHECK (candidate_order IN ('fixed', 'alphabetical', 'ballot_number', 'random'));
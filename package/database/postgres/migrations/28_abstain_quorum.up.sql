@@ -0,0 +1,2 @@
+-- pemilih yang abstain (pilihan abstain eksplisit atau jawaban abstain) tidak dihitung hadir untuk quorum
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS quorum_excludes_abstain BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,18 @@
+-- penyelesaian seri pada batas kursi terakhir: runoff (election putaran berikutnya), lottery, earliest_registration
+ALTER TABLE contests ADD COLUMN IF NOT EXISTS tie_break VARCHAR(30) NOT NULL DEFAULT 'runoff';
+ALTER TABLE contests DROP CONSTRAINT IF EXISTS contests_tie_break_check;
+ALTER TABLE contests ADD CONSTRAINT contests_tie_break_check CHECK (tie_break IN ('runoff', 'lottery', 'earliest_registration'));
+
+-- election putaran berikutnya untuk contest yang seri, satu runoff per contest.
+-- Jika election runoff (masih draft) dihapus, catatannya ikut terhapus sehingga runoff bisa dibuat ulang
+CREATE TABLE IF NOT EXISTS election_runoffs (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    contest_id INT NOT NULL UNIQUE REFERENCES contests(id) ON DELETE CASCADE,
+    runoff_election_id INT NOT NULL UNIQUE REFERENCES elections(id) ON DELETE CASCADE,
+    seats INT NOT NULL CHECK (seats > 0),
+    candidate_ids INT[] NOT NULL,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS election_runoffs_election_id_idx ON election_runoffs (election_id);
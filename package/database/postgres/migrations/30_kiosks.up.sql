@@ -0,0 +1,34 @@
+-- petugas TPS: role poll_worker yang berlaku untuk satu election saja, mengaktifkan kiosk dan membuka surat suara
+INSERT INTO roles (name, description)
+VALUES ('poll_worker', 'Election poll worker role')
+ON CONFLICT (name) DO NOTHING;
+
+-- perangkat kiosk TPS yang didaftarkan admin. device_key_hash adalah hash kunci perangkat yang ditanam di kiosk,
+-- session_hash adalah hash sesi kiosk token terakhir sehingga aktivasi ulang membatalkan token lama
+CREATE TABLE IF NOT EXISTS kiosk_devices (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    device_key_hash VARCHAR(64) NOT NULL,
+    session_hash VARCHAR(64),
+    ballots_cast INT NOT NULL DEFAULT 0,
+    registered_by INT REFERENCES users(id) ON DELETE SET NULL,
+    authenticated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    authenticated_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (election_id, name)
+);
+
+-- surat suara yang dibuka petugas untuk pemilih yang sudah check-in, paling banyak satu per kiosk dan satu per pemilih.
+-- Baris dihapus saat suara disimpan supaya urutan pembukaan tidak bisa dicocokkan dengan urutan rantai suara
+CREATE TABLE IF NOT EXISTS kiosk_unlocks (
+    id SERIAL NOT NULL PRIMARY KEY,
+    kiosk_id INT NOT NULL UNIQUE REFERENCES kiosk_devices(id) ON DELETE CASCADE,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    voter_roll_id INT NOT NULL UNIQUE REFERENCES voter_rolls(id) ON DELETE CASCADE,
+    unlocked_by INT REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS kiosk_unlocks_election_id_idx ON kiosk_unlocks (election_id);
@@ -0,0 +1,9 @@
+-- voter_tag lama adalah HMAC entri DPT dengan key turunan JWT_SECRET: siapa pun yang memegang config aplikasi
+-- bisa menghitungnya ulang untuk setiap entri DPT lalu men-join isi suara dengan pemilih.
+-- Diganti revote_tag, hash dari token pemungutan ulang acak yang hanya dipegang pemilih (dikembalikan di bukti suara).
+-- Tag lama dihapus; pemilih yang sudah memilih sebelum migration ini tidak memegang token sehingga tidak bisa memilih ulang
+DROP INDEX IF EXISTS ballots_election_voter_tag_idx;
+ALTER TABLE ballots RENAME COLUMN voter_tag TO revote_tag;
+UPDATE ballots SET revote_tag = NULL WHERE revote_tag IS NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ballots_election_revote_tag_key ON ballots (election_id, revote_tag) WHERE revote_tag IS NOT NULL;
@@ -0,0 +1,5 @@
+-- waktu pemakaian kode voting bisa diurutkan lalu dicocokkan dengan urutan rantai suara,
+-- cukup simpan bahwa kode sudah terpakai
+ALTER TABLE voting_codes ADD COLUMN IF NOT EXISTS used BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE voting_codes SET used = TRUE WHERE used_at IS NOT NULL;
+ALTER TABLE voting_codes DROP COLUMN IF EXISTS used_at;
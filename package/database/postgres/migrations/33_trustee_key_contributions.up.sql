@@ -0,0 +1,45 @@
+-- kunci election tidak lagi dibuat server: setiap trustee membuat kontribusi kunci di perangkatnya sendiri,
+-- membagi private key-nya dengan Shamir ke seluruh trustee, dan hanya mengirim public key beserta share terenkripsi.
+-- Ballot dienkripsi berlapis ke seluruh kontribusi. trustee_id kosong berarti kunci lama buatan server
+CREATE TABLE IF NOT EXISTS election_key_contributions (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    trustee_id INT UNIQUE REFERENCES election_trustees(id) ON DELETE CASCADE,
+    public_key TEXT NOT NULL,
+    private_key TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS election_key_contributions_election_id_idx ON election_key_contributions (election_id);
+
+-- share private key kontribusi untuk satu trustee, terenkripsi ke public key trustee tersebut.
+-- share asli baru dikirim trustee setelah election ditutup
+CREATE TABLE IF NOT EXISTS election_key_shares (
+    contribution_id INT NOT NULL REFERENCES election_key_contributions(id) ON DELETE CASCADE,
+    trustee_id INT NOT NULL REFERENCES election_trustees(id) ON DELETE CASCADE,
+    encrypted_share TEXT NOT NULL,
+    share_hash VARCHAR(64) NOT NULL,
+    context TEXT NOT NULL,
+    share TEXT,
+    PRIMARY KEY (contribution_id, trustee_id)
+);
+
+-- key ceremony lama menjadi satu kontribusi tanpa trustee dengan share yang sudah dibagikan
+INSERT INTO election_key_contributions (election_id, public_key, private_key, created_at)
+SELECT election_id, public_key, private_key, created_at FROM election_keys;
+
+INSERT INTO election_key_shares (contribution_id, trustee_id, encrypted_share, share_hash, context, share)
+SELECT c.id, t.id, t.encrypted_share, t.share_hash, 'election:' || t.election_id || ':trustee:' || t.id, t.share
+FROM election_trustees t
+JOIN election_key_contributions c ON c.election_id = t.election_id AND c.trustee_id IS NULL
+WHERE t.encrypted_share IS NOT NULL AND t.share_hash IS NOT NULL;
+
+-- completed_at terisi setelah seluruh trustee mengirim kontribusi, baru saat itu ballot bisa dienkripsi
+ALTER TABLE election_keys ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+UPDATE election_keys SET completed_at = created_at;
+ALTER TABLE election_keys DROP COLUMN IF EXISTS public_key;
+ALTER TABLE election_keys DROP COLUMN IF EXISTS private_key;
+
+ALTER TABLE election_trustees ADD COLUMN IF NOT EXISTS contributed_at TIMESTAMP;
+ALTER TABLE election_trustees DROP COLUMN IF EXISTS encrypted_share;
+ALTER TABLE election_trustees DROP COLUMN IF EXISTS share_hash;
+ALTER TABLE election_trustees DROP COLUMN IF EXISTS share;
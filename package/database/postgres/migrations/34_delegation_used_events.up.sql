@@ -0,0 +1,13 @@
+-- used_at dan event 'used' yang ditulis saat suara disimpan bisa diurutkan lalu dicocokkan dengan urutan
+-- rantai suara. Status 'used' cukup tanpa waktu, event 'used' baru dicatat sekaligus saat election ditutup
+ALTER TABLE election_delegations DROP COLUMN IF EXISTS used_at;
+
+DELETE FROM election_delegation_events WHERE event = 'used';
+
+INSERT INTO election_delegation_events (election_id, delegation_id, event, actor_user_id, created_at)
+SELECT d.election_id, d.id, 'used', v.user_id, e.closed_at
+FROM election_delegations d
+JOIN elections e ON e.id = d.election_id
+JOIN voter_rolls v ON v.id = d.proxy_voter_roll_id
+WHERE d.status = 'used' AND e.closed_at IS NOT NULL
+ORDER BY d.election_id, d.id;
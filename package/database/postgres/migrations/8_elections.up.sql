@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS elections (
+    id SERIAL NOT NULL PRIMARY KEY,
+    organization_id INT NOT NULL REFERENCES organizations(id),
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'scheduled', 'open', 'closed', 'published')),
+    start_at TIMESTAMP,
+    end_at TIMESTAMP,
+    created_by INT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    scheduled_at TIMESTAMP,
+    opened_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    published_at TIMESTAMP,
+    CHECK (start_at IS NULL OR end_at IS NULL OR start_at < end_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_elections_organization_id ON elections(organization_id);
+
+CREATE TABLE IF NOT EXISTS election_status_logs (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    changed_by INT REFERENCES users(id),
+    note TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_election_status_logs_election_id ON election_status_logs(election_id);
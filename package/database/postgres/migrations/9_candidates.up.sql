@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS candidates (
+    id SERIAL NOT NULL PRIMARY KEY,
+    election_id INT NOT NULL REFERENCES elections(id) ON DELETE CASCADE,
+    ballot_number INT NOT NULL CHECK (ballot_number > 0),
+    name VARCHAR(255) NOT NULL,
+    photo_url TEXT NOT NULL DEFAULT '',
+    vision TEXT NOT NULL DEFAULT '',
+    mission TEXT NOT NULL DEFAULT '',
+    running_mate_name VARCHAR(255),
+    running_mate_photo_url TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- DEFERRABLE supaya nomor urut bisa ditukar dalam satu statement (undian)
+    CONSTRAINT candidates_election_ballot_number_key UNIQUE (election_id, ballot_number) DEFERRABLE INITIALLY IMMEDIATE
+);
@@ -0,0 +1,151 @@
+// Package electioncrypto mengenkripsi isi ballot ke public key election (ECIES):
+// X25519 ephemeral, kunci diturunkan dengan HKDF-SHA256, lalu AES-256-GCM.
+// Hanya memakai package crypto standar Go.
+package electioncrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+// KeySize - panjang public key dan private key X25519
+const KeySize = 32
+
+var (
+	ErrInvalidCiphertext = errors.New("electioncrypto: ciphertext tidak valid")
+	ErrNoRecipients      = errors.New("electioncrypto: penerima kosong")
+)
+
+// GenerateKey - Buat pasangan kunci X25519 baru
+func GenerateKey() (*ecdh.PrivateKey, error) {
+	return ecdh.X25519().GenerateKey(rand.Reader)
+}
+
+// ParsePublicKey - Baca public key X25519 berformat base64
+func ParsePublicKey(encoded string) (*ecdh.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return ecdh.X25519().NewPublicKey(raw)
+}
+
+// ParsePrivateKey - Baca private key X25519 dari 32 byte mentah
+func ParsePrivateKey(raw []byte) (*ecdh.PrivateKey, error) {
+	return ecdh.X25519().NewPrivateKey(raw)
+}
+
+// EncodeKey - Format base64 untuk public / private key yang disimpan atau dikirim lewat API
+func EncodeKey(raw []byte) string {
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+// Encrypt - Enkripsi plaintext untuk pemilik public key. context (mis. id election) diikat ke
+// turunan kunci dan AAD sehingga ciphertext tidak bisa dipindah ke konteks lain.
+// Hasil: public key ephemeral || nonce || ciphertext
+func Encrypt(recipient *ecdh.PublicKey, plaintext []byte, context string) ([]byte, error) {
+	ephemeral, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(shared, ephemeral.PublicKey().Bytes(), recipient.Bytes(), context)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, KeySize+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, ephemeral.PublicKey().Bytes()...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, []byte(context)), nil
+}
+
+// Decrypt - Buka ciphertext hasil Encrypt dengan private key penerima dan context yang sama
+func Decrypt(private *ecdh.PrivateKey, ciphertext []byte, context string) ([]byte, error) {
+	if len(ciphertext) < KeySize {
+		return nil, ErrInvalidCiphertext
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(ciphertext[:KeySize])
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	shared, err := private.ECDH(ephemeral)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	aead, err := newAEAD(shared, ephemeral.Bytes(), private.PublicKey().Bytes(), context)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := ciphertext[KeySize:]
+	if len(rest) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrInvalidCiphertext
+	}
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(context))
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
+
+// EncryptLayers - Enkripsi berlapis ke beberapa penerima: lapisan pertama untuk recipients[0], lapisan
+// terluar untuk penerima terakhir. Plaintext hanya bisa dibuka jika private key seluruh penerima tersedia
+func EncryptLayers(recipients []*ecdh.PublicKey, plaintext []byte, context string) ([]byte, error) {
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+	sealed := plaintext
+	for _, recipient := range recipients {
+		var err error
+		if sealed, err = Encrypt(recipient, sealed, context); err != nil {
+			return nil, err
+		}
+	}
+	return sealed, nil
+}
+
+// DecryptLayers - Buka ciphertext hasil EncryptLayers, privates harus berurutan sama dengan recipients
+func DecryptLayers(privates []*ecdh.PrivateKey, ciphertext []byte, context string) ([]byte, error) {
+	if len(privates) == 0 {
+		return nil, ErrNoRecipients
+	}
+	opened := ciphertext
+	for i := len(privates) - 1; i >= 0; i-- {
+		var err error
+		if opened, err = Decrypt(privates[i], opened, context); err != nil {
+			return nil, err
+		}
+	}
+	return opened, nil
+}
+
+func newAEAD(shared, ephemeral, recipient []byte, context string) (cipher.AEAD, error) {
+	salt := append(append([]byte{}, ephemeral...), recipient...)
+	key, err := hkdf.Key(sha256.New, shared, salt, "enyoblos ballot "+context, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
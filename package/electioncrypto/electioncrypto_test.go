@@ -0,0 +1,109 @@
+package electioncrypto
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _ := GenerateKey()
+	plaintext := []byte(`{"contests":[{"contest_id":1,"choices":[3]}]}`)
+
+	ciphertext, err := Encrypt(key.PublicKey(), plaintext, "election:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := bytes.Clone(ciphertext)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name       string
+		ciphertext []byte
+		key        []byte
+		context    string
+		wantErr    bool
+	}{
+		{name: "round trip", ciphertext: ciphertext, key: key.Bytes(), context: "election:1"},
+		{name: "wrong key", ciphertext: ciphertext, key: other.Bytes(), context: "election:1", wantErr: true},
+		{name: "wrong context", ciphertext: ciphertext, key: key.Bytes(), context: "election:2", wantErr: true},
+		{name: "tampered", ciphertext: tampered, key: key.Bytes(), context: "election:1", wantErr: true},
+		{name: "truncated", ciphertext: ciphertext[:40], key: key.Bytes(), context: "election:1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			private, err := ParsePrivateKey(tt.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Decrypt(private, tt.ciphertext, tt.context)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decrypt: %v", err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Fatalf("got %s, want %s", got, plaintext)
+			}
+		})
+	}
+}
+
+func TestEncryptDecryptLayers(t *testing.T) {
+	keys := make([]*ecdh.PrivateKey, 3)
+	publics := make([]*ecdh.PublicKey, len(keys))
+	for i := range keys {
+		key, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i], publics[i] = key, key.PublicKey()
+	}
+	other, _ := GenerateKey()
+	plaintext := []byte(`{"contests":[{"contest_id":1,"choices":[3]}]}`)
+
+	ciphertext, err := EncryptLayers(publics, plaintext, "election:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		keys    []*ecdh.PrivateKey
+		wantErr bool
+	}{
+		{name: "all keys", keys: keys},
+		{name: "missing key", keys: keys[:2], wantErr: true},
+		{name: "wrong order", keys: []*ecdh.PrivateKey{keys[2], keys[1], keys[0]}, wantErr: true},
+		{name: "wrong key", keys: []*ecdh.PrivateKey{keys[0], other, keys[2]}, wantErr: true},
+		{name: "no keys", keys: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecryptLayers(tt.keys, ciphertext, "election:1")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecryptLayers: %v", err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Fatalf("got %s, want %s", got, plaintext)
+			}
+		})
+	}
+}
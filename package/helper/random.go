@@ -0,0 +1,23 @@
+package helper
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// codeAlphabet - huruf dan angka tanpa karakter yang mudah tertukar (0/O, 1/I/L)
+const codeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// RandomCode membuat kode acak (crypto/rand) sepanjang length karakter dari codeAlphabet
+func RandomCode(length int) (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = codeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
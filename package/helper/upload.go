@@ -0,0 +1,49 @@
+package helper
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUploadEmpty       = errors.New("uploaded file is empty")
+	ErrUploadTooLarge    = errors.New("uploaded file is too large")
+	ErrUploadUnsupported = errors.New("uploaded file type is not allowed")
+)
+
+// ReadUpload membaca file unggahan maksimal maxSize byte. Jenis file dideteksi dari isinya,
+// bukan dari Content-Type kiriman klien, dan harus termasuk allowedTypes
+func ReadUpload(file io.Reader, maxSize int, allowedTypes []string) (content []byte, contentType string, err error) {
+	content, err = io.ReadAll(io.LimitReader(file, int64(maxSize)+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(content) == 0 {
+		return nil, "", ErrUploadEmpty
+	}
+	if len(content) > maxSize {
+		return nil, "", ErrUploadTooLarge
+	}
+
+	contentType = http.DetectContentType(content)
+	if !slices.Contains(allowedTypes, contentType) {
+		return nil, "", ErrUploadUnsupported
+	}
+	return content, contentType, nil
+}
+
+// UploadFileName membuang path dari nama file unggahan dan membatasinya 255 karakter
+func UploadFileName(name string) string {
+	name = filepath.Base(strings.TrimSpace(strings.ReplaceAll(name, "\\", "/")))
+	if name == "." || name == "/" {
+		return "dokumen"
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
@@ -9,8 +9,32 @@ import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/valyala/fasthttp"
 )
 
+// sensitiveQueryKeys - parameter query berisi token yang tidak boleh ikut tercatat di log request
+var sensitiveQueryKeys = []string{"access_token", "stream_token", "token"}
+
+// loggedURL - OriginalURL dengan nilai token di query string diganti REDACTED
+func loggedURL(c fiber.Ctx) string {
+	uri := fasthttp.AcquireURI()
+	defer fasthttp.ReleaseURI(uri)
+	c.Request().URI().CopyTo(uri)
+
+	args := uri.QueryArgs()
+	redacted := false
+	for _, key := range sensitiveQueryKeys {
+		if args.Has(key) {
+			args.Set(key, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return c.OriginalURL()
+	}
+	return string(uri.RequestURI())
+}
+
 // InitLogger untuk inisialisasi zerolog dengan warna
 func InitLogger(env string) {
 	output := zerolog.ConsoleWriter{
@@ -76,18 +100,18 @@ func NewLogger() fiber.Handler {
 			msg = string(byteRes)
 			log.Warn().
 				Msgf("%s%d\033[0m | %.2f ms | %s %s | %s | %s | %s",
-					statusColor, statusCode, latency, c.Method(), c.OriginalURL(), c.Get("User-Agent"), c.IP(), msg)
+					statusColor, statusCode, latency, c.Method(), loggedURL(c), c.Get("User-Agent"), c.IP(), msg)
 
 		} else if c.Response().StatusCode() >= 500 {
 			byteRes := c.Response().Body()
 			msg = string(byteRes)
 			log.Error().
 				Msgf("%s%d\033[0m | %.2f ms | %s %s | %s | %s | %s ",
-					statusColor, statusCode, latency, c.Method(), c.OriginalURL(), c.Get("User-Agent"), c.IP(), msg)
+					statusColor, statusCode, latency, c.Method(), loggedURL(c), c.Get("User-Agent"), c.IP(), msg)
 		} else {
 			log.Info().
 				Msgf("%s%d\033[0m | %.2f ms | %s %s | %s | %s | %s",
-					statusColor, statusCode, latency, c.Method(), c.OriginalURL(), c.Get("User-Agent"), c.IP(), msg)
+					statusColor, statusCode, latency, c.Method(), loggedURL(c), c.Get("User-Agent"), c.IP(), msg)
 
 		}
 
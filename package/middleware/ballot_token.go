@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/madmuzz05/be-enyoblos/config"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// ballotTokenTTL - masa berlaku ballot token hasil penukaran kode voting
+const ballotTokenTTL = 15 * time.Minute
+
+// BallotClaims - Isi ballot token, mengikat token ke satu entri DPT dan kode voting
+type BallotClaims struct {
+	ElectionID  int
+	VoterRollID int
+	CodeHash    string
+}
+
+func ballotTokenSecret() []byte {
+	return []byte(config.AppConfig.JwtSecret + "_ballot") // beda secret dengan access token
+}
+
+// GenerateBallotToken - Buat ballot token berumur pendek untuk pemilih tanpa akun.
+// Token hanya bisa dipakai untuk satu suara: kode voting ditandai terpakai saat suara disimpan
+func GenerateBallotToken(electionID int, voterRollID int, codeHash string) (GenerateTokenRes, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"key":           config.AppConfig.JwtKey,
+		"election_id":   electionID,
+		"voter_roll_id": voterRollID,
+		"code_hash":     codeHash,
+		"iat":           now.Unix(),
+		"exp":           now.Add(ballotTokenTTL).Unix(),
+		"type":          "ballot",
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token, err := t.SignedString(ballotTokenSecret())
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	expired, err := helper.ParseStringToCustomTime(now.Add(ballotTokenTTL).Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	return GenerateTokenRes{
+		AccessToken: token,
+		ExpiresIn:   expired,
+	}, nil
+}
+
+// BallotTokenMiddleware verifies ballot token and sets claims to ctx.Locals("ballot_claims")
+func BallotTokenMiddleware(handler fiber.Handler) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		auth := c.Get("Authorization")
+		parts := strings.SplitN(auth, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Ballot token required", nil)
+		}
+
+		token, err := jwt.Parse(parts[1], func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return ballotTokenSecret(), nil
+		})
+		if err != nil || !token.Valid {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid or expired ballot token", nil)
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || claims["type"] != "ballot" {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid ballot token claims", nil)
+		}
+		electionID, okElection := claims["election_id"].(float64)
+		voterRollID, okVoter := claims["voter_roll_id"].(float64)
+		codeHash, okCode := claims["code_hash"].(string)
+		if !okElection || !okVoter || !okCode {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid ballot token claims", nil)
+		}
+
+		c.Locals("ballot_claims", BallotClaims{
+			ElectionID:  int(electionID),
+			VoterRollID: int(voterRollID),
+			CodeHash:    codeHash,
+		})
+		return handler(c)
+	}
+}
+
+// GetBallotClaims mengambil claims yang di-set oleh BallotTokenMiddleware
+func GetBallotClaims(c fiber.Ctx) (BallotClaims, error) {
+	claims, ok := c.Locals("ballot_claims").(BallotClaims)
+	if !ok {
+		return BallotClaims{}, fmt.Errorf("ballot claims not found")
+	}
+	return claims, nil
+}
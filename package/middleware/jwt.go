@@ -141,6 +141,19 @@ func JWTHS256Middleware(redisClient *redisdb.RedisClient, handler fiber.Handler,
 	}
 }
 
+// GetUserID mengambil user_id dari claims yang di-set oleh JWTHS256Middleware
+func GetUserID(c fiber.Ctx) (int, error) {
+	claims, ok := c.Locals("user_claims").(jwt.MapClaims)
+	if !ok {
+		return 0, fmt.Errorf("user claims not found")
+	}
+	userID, ok := claims["user_id"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid user_id claim")
+	}
+	return int(userID), nil
+}
+
 func GenerateRefreshToken(userID int, role string, deviceID string) (GenerateTokenRes, error) {
 	ttl := 7 * 24 * time.Hour                         // 7 hari
 	secret := config.AppConfig.JwtSecret + "_refresh" // beda secret untuk refresh
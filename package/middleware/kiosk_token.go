@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/madmuzz05/be-enyoblos/config"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// kioskTokenTTL - masa berlaku kiosk token, cukup untuk satu hari pemungutan suara di TPS
+const kioskTokenTTL = 12 * time.Hour
+
+// KioskClaims - Isi kiosk token, mengikat token ke satu perangkat kiosk terdaftar dan sesi aktivasinya.
+// Berbeda dengan device_id dari GenerateDeviceID yang hanya sidik jari perangkat saat login
+type KioskClaims struct {
+	ElectionID int
+	KioskID    int
+	Session    string
+}
+
+func kioskTokenSecret() []byte {
+	return []byte(config.AppConfig.JwtSecret + "_kiosk") // beda secret dengan access token dan ballot token
+}
+
+// GenerateKioskToken - Buat kiosk token setelah petugas TPS mengaktifkan perangkat kiosk.
+// Token sendiri tidak bisa memberikan suara, tiap suara butuh surat suara yang dibuka petugas
+func GenerateKioskToken(electionID int, kioskID int, session string) (GenerateTokenRes, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"key":         config.AppConfig.JwtKey,
+		"election_id": electionID,
+		"kiosk_id":    kioskID,
+		"session":     session,
+		"iat":         now.Unix(),
+		"exp":         now.Add(kioskTokenTTL).Unix(),
+		"type":        "kiosk",
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token, err := t.SignedString(kioskTokenSecret())
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	expired, err := helper.ParseStringToCustomTime(now.Add(kioskTokenTTL).Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	return GenerateTokenRes{
+		AccessToken: token,
+		ExpiresIn:   expired,
+	}, nil
+}
+
+// KioskTokenMiddleware verifies kiosk token and sets claims to ctx.Locals("kiosk_claims")
+func KioskTokenMiddleware(handler fiber.Handler) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		auth := c.Get("Authorization")
+		parts := strings.SplitN(auth, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Kiosk token required", nil)
+		}
+
+		token, err := jwt.Parse(parts[1], func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return kioskTokenSecret(), nil
+		})
+		if err != nil || !token.Valid {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid or expired kiosk token", nil)
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || claims["type"] != "kiosk" {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid kiosk token claims", nil)
+		}
+		electionID, okElection := claims["election_id"].(float64)
+		kioskID, okKiosk := claims["kiosk_id"].(float64)
+		session, okSession := claims["session"].(string)
+		if !okElection || !okKiosk || !okSession {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid kiosk token claims", nil)
+		}
+
+		c.Locals("kiosk_claims", KioskClaims{
+			ElectionID: int(electionID),
+			KioskID:    int(kioskID),
+			Session:    session,
+		})
+		return handler(c)
+	}
+}
+
+// GetKioskClaims mengambil claims yang di-set oleh KioskTokenMiddleware
+func GetKioskClaims(c fiber.Ctx) (KioskClaims, error) {
+	claims, ok := c.Locals("kiosk_claims").(KioskClaims)
+	if !ok {
+		return KioskClaims{}, fmt.Errorf("kiosk claims not found")
+	}
+	return claims, nil
+}
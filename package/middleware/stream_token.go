@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/madmuzz05/be-enyoblos/config"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+)
+
+// streamTokenTTL - masa berlaku stream token, hanya untuk membuka (atau menyambung ulang) koneksi stream
+const streamTokenTTL = 5 * time.Minute
+
+func streamTokenSecret() []byte {
+	return []byte(config.AppConfig.JwtSecret + "_stream") // beda secret dengan access token
+}
+
+// GenerateStreamToken - Buat stream token berumur pendek untuk EventSource yang tidak bisa mengirim header Authorization.
+// Token hanya berlaku untuk stream live satu election, sehingga access token tidak pernah muncul di query string
+func GenerateStreamToken(userID int, electionID int) (GenerateTokenRes, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"key":         config.AppConfig.JwtKey,
+		"user_id":     userID,
+		"election_id": electionID,
+		"iat":         now.Unix(),
+		"exp":         now.Add(streamTokenTTL).Unix(),
+		"type":        "stream",
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token, err := t.SignedString(streamTokenSecret())
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	expired, err := helper.ParseStringToCustomTime(now.Add(streamTokenTTL).Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return GenerateTokenRes{}, err
+	}
+	return GenerateTokenRes{
+		AccessToken: token,
+		ExpiresIn:   expired,
+	}, nil
+}
+
+// StreamTokenMiddleware - Verifikasi ?stream_token= untuk election pada parameter :id lalu set user_claims
+// seperti JWTHS256Middleware. Tanpa stream_token, request diteruskan ke JWTHS256Middleware (header Authorization)
+func StreamTokenMiddleware(redisClient *redisdb.RedisClient, handler fiber.Handler) fiber.Handler {
+	withHeader := JWTHS256Middleware(redisClient, handler)
+	return func(c fiber.Ctx) error {
+		tokenStr := c.Query("stream_token")
+		if tokenStr == "" {
+			return withHeader(c)
+		}
+
+		token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return streamTokenSecret(), nil
+		})
+		if err != nil || !token.Valid {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid or expired stream token", nil)
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok || claims["type"] != "stream" {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid stream token claims", nil)
+		}
+		userID, okUser := claims["user_id"].(float64)
+		electionID, okElection := claims["election_id"].(float64)
+		if !okUser || !okElection {
+			return helper.SendResponse(c, fiber.StatusUnauthorized, "Invalid stream token claims", nil)
+		}
+		if strconv.Itoa(int(electionID)) != c.Params("id") {
+			return helper.SendResponse(c, fiber.StatusForbidden, "Stream token bukan untuk election ini", nil)
+		}
+
+		// 🚫 Token user yang sudah di-revoke ikut membatalkan stream token
+		if redisClient != nil {
+			revokeKey := fmt.Sprintf("revoke:user:%d", int(userID))
+			if val, err := redisClient.Client.Get(redisClient.Ctx, revokeKey).Result(); err == nil && val == "true" {
+				return helper.SendResponse(c, fiber.StatusUnauthorized, "User tokens have been revoked", nil)
+			}
+		}
+
+		c.Locals("user_claims", jwt.MapClaims{"user_id": userID})
+		return handler(c)
+	}
+}
@@ -0,0 +1,54 @@
+package redisdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	EventBallotCast    = "ballot_cast"
+	EventStatusChanged = "status_changed"
+)
+
+// ElectionEvent - Notifikasi perubahan pada satu election, dikirim lewat pub/sub ke semua instance
+type ElectionEvent struct {
+	Type       string `json:"type"`
+	ElectionID int    `json:"election_id"`
+	Status     string `json:"status,omitempty"`
+}
+
+// ElectionEventChannel - Nama channel pub/sub untuk event satu election
+func ElectionEventChannel(electionID int) string {
+	return fmt.Sprintf("election:%d:events", electionID)
+}
+
+// PublishElectionEvent - Kirim event election. Gagal publish hanya dicatat di log,
+// subscriber tetap mendapat data terbaru pada event berikutnya
+func (r *RedisClient) PublishElectionEvent(event ElectionEvent) {
+	if r == nil {
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err == nil {
+		err = r.Client.Publish(r.Ctx, ElectionEventChannel(event.ElectionID), payload).Err()
+	}
+	if err != nil {
+		log.Warn().Err(err).Int("election_id", event.ElectionID).Str("type", event.Type).Msg("failed to publish election event")
+	}
+}
+
+// SubscribeElectionEvents - Langganan event satu election, PubSub wajib di-Close oleh pemanggil
+func (r *RedisClient) SubscribeElectionEvents(ctx context.Context, electionID int) (*redis.PubSub, error) {
+	pubsub := r.Client.Subscribe(ctx, ElectionEventChannel(electionID))
+	// tunggu konfirmasi subscribe supaya event setelah ini tidak terlewat
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	return pubsub, nil
+}
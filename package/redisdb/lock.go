@@ -0,0 +1,36 @@
+package redisdb
+
+import (
+	"time"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/redis/go-redis/v9"
+)
+
+// releaseLockScript - hapus lock hanya jika masih dipegang oleh token yang sama
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// AcquireLock - Ambil lock terdistribusi (SET NX) dengan masa berlaku ttl.
+// ok false berarti lock sedang dipegang instance lain. Token dipakai untuk ReleaseLock
+func (r *RedisClient) AcquireLock(key string, ttl time.Duration) (token string, ok bool, err error) {
+	token, err = helper.RandomCode(16)
+	if err != nil {
+		return "", false, err
+	}
+
+	ok, err = r.Client.SetNX(r.Ctx, "lock:"+key, token, ttl).Result()
+	if err != nil || !ok {
+		return "", false, err
+	}
+	return token, true, nil
+}
+
+// ReleaseLock - Lepas lock yang diambil dengan AcquireLock, lock yang sudah kedaluwarsa
+// lalu diambil instance lain tidak ikut terhapus
+func (r *RedisClient) ReleaseLock(key string, token string) error {
+	return releaseLockScript.Run(r.Ctx, r.Client, []string{"lock:" + key}, token).Err()
+}
@@ -0,0 +1,112 @@
+// Package shamir membagi rahasia menjadi n share dengan ambang k (Shamir's Secret Sharing)
+// di atas GF(2^8), setiap byte rahasia dibagi dengan polinomial acak tersendiri.
+// Setiap share diawali satu byte koordinat x (1..255) diikuti nilai polinomial per byte rahasia.
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+var (
+	ErrInvalidThreshold = errors.New("shamir: threshold harus 1..n dan n maksimal 255")
+	ErrEmptySecret      = errors.New("shamir: rahasia kosong")
+	ErrInvalidShares    = errors.New("shamir: share tidak valid")
+)
+
+// Split - Bagi secret menjadi n share, sembarang k share cukup untuk menyusunnya kembali
+func Split(secret []byte, n, k int) ([][]byte, error) {
+	if k < 1 || n < k || n > 255 {
+		return nil, ErrInvalidThreshold
+	}
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][0] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, k)
+	for j, value := range secret {
+		coefficients[0] = value
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for i := range shares {
+			shares[i][j+1] = evaluate(coefficients, shares[i][0])
+		}
+	}
+	clear(coefficients)
+	return shares, nil
+}
+
+// Combine - Susun kembali rahasia dari share (interpolasi Lagrange di x = 0).
+// Jumlah share harus minimal ambang saat Split, jika kurang hasilnya bukan rahasia asli
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrInvalidShares
+	}
+
+	length := len(shares[0])
+	seen := make(map[byte]bool, len(shares))
+	for _, share := range shares {
+		if len(share) != length || length < 2 || share[0] == 0 || seen[share[0]] {
+			return nil, ErrInvalidShares
+		}
+		seen[share[0]] = true
+	}
+
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		// basis Lagrange l_i(0) = prod x_m / (x_m - x_i), pengurangan di GF(2^8) adalah xor
+		basis := byte(1)
+		for m, other := range shares {
+			if m == i {
+				continue
+			}
+			basis = mul(basis, div(other[0], other[0]^share[0]))
+		}
+		for j := range secret {
+			secret[j] ^= mul(share[j+1], basis)
+		}
+	}
+	return secret, nil
+}
+
+// evaluate - Nilai polinomial di x dengan metode Horner
+func evaluate(coefficients []byte, x byte) byte {
+	result := byte(0)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = mul(result, x) ^ coefficients[i]
+	}
+	return result
+}
+
+// mul - Perkalian GF(2^8) dengan polinomial reduksi AES (x^8 + x^4 + x^3 + x + 1)
+func mul(a, b byte) byte {
+	var result byte
+	for b > 0 {
+		if b&1 == 1 {
+			result ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return result
+}
+
+// div - Pembagian GF(2^8), invers dihitung dengan a^254
+func div(a, b byte) byte {
+	inverse := b
+	for range 6 {
+		inverse = mul(mul(inverse, inverse), b)
+	}
+	return mul(a, mul(inverse, inverse))
+}
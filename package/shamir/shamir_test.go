@@ -0,0 +1,61 @@
+package shamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("kunci rahasia election 32 bytes!")
+
+	tests := []struct {
+		name    string
+		n, k    int
+		use     []int
+		recover bool
+	}{
+		{name: "threshold shares", n: 5, k: 3, use: []int{0, 2, 4}, recover: true},
+		{name: "all shares", n: 5, k: 3, use: []int{0, 1, 2, 3, 4}, recover: true},
+		{name: "other subset", n: 5, k: 3, use: []int{3, 1, 2}, recover: true},
+		{name: "single trustee", n: 1, k: 1, use: []int{0}, recover: true},
+		{name: "below threshold", n: 5, k: 3, use: []int{0, 4}, recover: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := Split(secret, tt.n, tt.k)
+			if err != nil {
+				t.Fatalf("Split: %v", err)
+			}
+
+			subset := make([][]byte, 0, len(tt.use))
+			for _, i := range tt.use {
+				subset = append(subset, shares[i])
+			}
+			got, err := Combine(subset)
+			if err != nil {
+				t.Fatalf("Combine: %v", err)
+			}
+			if bytes.Equal(got, secret) != tt.recover {
+				t.Fatalf("recovered = %v, want %v", bytes.Equal(got, secret), tt.recover)
+			}
+		})
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	if _, err := Split([]byte("x"), 2, 3); err != ErrInvalidThreshold {
+		t.Fatalf("Split k > n: got %v", err)
+	}
+	if _, err := Split(nil, 3, 2); err != ErrEmptySecret {
+		t.Fatalf("Split empty: got %v", err)
+	}
+
+	shares, _ := Split([]byte("secret"), 3, 2)
+	if _, err := Combine([][]byte{shares[0], shares[0]}); err != ErrInvalidShares {
+		t.Fatalf("Combine duplicate: got %v", err)
+	}
+	if _, err := Combine([][]byte{shares[0], shares[1][:3]}); err != ErrInvalidShares {
+		t.Fatalf("Combine length mismatch: got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+// Package signer menandatangani dokumen resmi (berita acara hasil election) dengan Ed25519.
+// Kunci server dibaca dari seed 32 byte sehingga tanda tangan tetap bisa diverifikasi setelah restart.
+package signer
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+)
+
+// Algorithm - nama algoritma yang dicantumkan pada dokumen bertanda tangan
+const Algorithm = "Ed25519"
+
+var (
+	ErrInvalidSeed      = errors.New("signer: seed harus 32 byte (base64)")
+	ErrInvalidPublicKey = errors.New("signer: public key harus 32 byte (base64)")
+)
+
+// Signer - Pemegang private key Ed25519 server
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// FromSeed - Buat Signer dari seed Ed25519 berformat base64
+func FromSeed(encoded string) (*Signer, error) {
+	seed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, ErrInvalidSeed
+	}
+	return &Signer{key: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// PublicKey - Public key server (base64) untuk verifikasi pihak luar
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// KeyID - Sidik jari public key, 16 karakter hex pertama SHA-256
+func (s *Signer) KeyID() string {
+	return KeyID(s.key.Public().(ed25519.PublicKey))
+}
+
+// Sign - Tanda tangan pesan (base64)
+func (s *Signer) Sign(message []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, message))
+}
+
+// ParsePublicKey - Baca public key Ed25519 berformat base64
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// KeyID - Sidik jari sebuah public key
+func KeyID(publicKey ed25519.PublicKey) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Verify - Cek tanda tangan (base64) atas pesan dengan public key
+func Verify(publicKey ed25519.PublicKey, message []byte, signature string) bool {
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(raw) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(publicKey, message, raw)
+}
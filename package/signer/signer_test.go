@@ -0,0 +1,59 @@
+package signer
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestSignVerify(t *testing.T) {
+	s, err := FromSeed(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _ := FromSeed(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+
+	message := []byte("BERITA ACARA\nelection_id: 1\n")
+	signature := s.Sign(message)
+
+	publicKey, err := ParsePublicKey(s.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, _ := ParsePublicKey(other.PublicKey())
+
+	tests := []struct {
+		name      string
+		message   []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", message: message, signature: signature, want: true},
+		{name: "changed message", message: []byte("BERITA ACARA\nelection_id: 2\n"), signature: signature},
+		{name: "other key signature", message: message, signature: other.Sign(message)},
+		{name: "malformed signature", message: message, signature: "not-base64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(publicKey, tt.message, tt.signature); got != tt.want {
+				t.Fatalf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if Verify(otherKey, message, signature) {
+		t.Fatal("signature verified with a different public key")
+	}
+	if s.KeyID() == other.KeyID() || len(s.KeyID()) != 16 {
+		t.Fatalf("unexpected key ids %q %q", s.KeyID(), other.KeyID())
+	}
+}
+
+func TestFromSeedInvalid(t *testing.T) {
+	for _, seed := range []string{"", "c2hvcnQ=", "%%%"} {
+		if _, err := FromSeed(seed); err != ErrInvalidSeed {
+			t.Fatalf("FromSeed(%q) error = %v, want ErrInvalidSeed", seed, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package tally
+
+// bordaCounter - peringkat ke-i (mulai 0) mendapat n-1-i poin, n = jumlah candidate.
+// Candidate yang tidak diberi peringkat mendapat 0 poin, poin dikalikan bobot ballot
+type bordaCounter struct{}
+
+func (bordaCounter) Validate(candidates []int, ballot Ballot, seats int) error {
+	return validateChoices(candidates, ballot)
+}
+
+func (bordaCounter) Count(candidates []int, ballots []Ballot, seats int) Result {
+	n := int64(len(candidates))
+	points := emptyVotes(candidates)
+	for _, ballot := range ballots {
+		for rank, choice := range ballot.Choices {
+			points[choice] += (n - 1 - int64(rank)) * ballot.weight()
+		}
+	}
+
+	scores := sortScores(points)
+	winners, tied := pickWinners(scores, seats)
+	return Result{Scores: scores, Winners: winners, Tied: tied}
+}
@@ -0,0 +1,108 @@
+package tally
+
+import "slices"
+
+// irvCounter - instant-runoff. Setiap putaran menghitung pilihan teratas yang masih bertahan,
+// candidate dengan suara terendah dieliminasi sampai ada mayoritas (1 kursi)
+// atau jumlah candidate tersisa sama dengan jumlah kursi.
+// Seri di posisi terendah diputus dengan melihat putaran sebelumnya (backward tie-break);
+// jika tetap seri, semuanya dieliminasi bersamaan kecuali jika eliminasi tersebut
+// membuat kursi tidak terisi, candidate tersebut dilaporkan sebagai Tied.
+// Suara dan mayoritas dihitung berdasarkan bobot ballot
+type irvCounter struct{}
+
+func (irvCounter) Validate(candidates []int, ballot Ballot, seats int) error {
+	return validateChoices(candidates, ballot)
+}
+
+func (irvCounter) Count(candidates []int, ballots []Ballot, seats int) Result {
+	continuing := slices.Clone(candidates)
+	result := Result{Winners: []int{}}
+
+	for number := 1; ; number++ {
+		votes := emptyVotes(continuing)
+		var total, exhausted int64
+		for _, ballot := range ballots {
+			total += ballot.weight()
+			if choice, ok := firstContinuing(ballot.Choices, continuing); ok {
+				votes[choice] += ballot.weight()
+			} else {
+				exhausted += ballot.weight()
+			}
+		}
+
+		scores := sortScores(votes)
+		round := Round{Number: number, Scores: scores, Exhausted: exhausted}
+		result.Scores = scores
+
+		active := total - exhausted
+		if seats == 1 && len(scores) > 0 && scores[0].Votes*2 > active {
+			result.Winners = []int{scores[0].CandidateID}
+			result.Rounds = append(result.Rounds, round)
+			return result
+		}
+
+		if len(continuing) <= seats {
+			for _, score := range scores {
+				result.Winners = append(result.Winners, score.CandidateID)
+			}
+			result.Rounds = append(result.Rounds, round)
+			return result
+		}
+
+		lowest := scores[len(scores)-1].Votes
+		var eliminated []int
+		for _, score := range scores {
+			if score.Votes == lowest {
+				eliminated = append(eliminated, score.CandidateID)
+			}
+		}
+		eliminated = breakTieBackward(eliminated, result.Rounds)
+
+		if len(continuing)-len(eliminated) < seats {
+			for _, score := range scores {
+				if score.Votes > lowest {
+					result.Winners = append(result.Winners, score.CandidateID)
+				}
+			}
+			result.Tied = eliminated
+			result.Rounds = append(result.Rounds, round)
+			return result
+		}
+
+		round.Eliminated = eliminated
+		result.Rounds = append(result.Rounds, round)
+		continuing = slices.DeleteFunc(continuing, func(candidateID int) bool {
+			return slices.Contains(eliminated, candidateID)
+		})
+	}
+}
+
+// breakTieBackward - sisakan candidate dengan suara terendah pada putaran terakhir yang membedakan mereka
+func breakTieBackward(tied []int, previous []Round) []int {
+	for i := len(previous) - 1; i >= 0 && len(tied) > 1; i-- {
+		votes := make(map[int]int64, len(previous[i].Scores))
+		for _, score := range previous[i].Scores {
+			votes[score.CandidateID] = score.Votes
+		}
+
+		lowest := votes[tied[0]]
+		for _, candidateID := range tied[1:] {
+			lowest = min(lowest, votes[candidateID])
+		}
+		tied = slices.DeleteFunc(tied, func(candidateID int) bool {
+			return votes[candidateID] != lowest
+		})
+	}
+	return tied
+}
+
+// firstContinuing - pilihan dengan peringkat tertinggi yang belum tereliminasi
+func firstContinuing(choices []int, continuing []int) (int, bool) {
+	for _, choice := range choices {
+		if slices.Contains(continuing, choice) {
+			return choice, true
+		}
+	}
+	return 0, false
+}
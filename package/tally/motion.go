@@ -0,0 +1,88 @@
+package tally
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidRatio = errors.New("tally: ambang harus berbentuk pecahan a/b dengan 0 < a <= b")
+
+// Ratio - Ambang berbentuk pecahan (mis. 2/3) supaya perbandingan tepat tanpa pembulatan desimal
+type Ratio struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// ParseRatio baca pecahan "a/b"
+func ParseRatio(value string) (Ratio, error) {
+	numerator, denominator, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Ratio{}, ErrInvalidRatio
+	}
+	n, errN := strconv.ParseInt(strings.TrimSpace(numerator), 10, 64)
+	d, errD := strconv.ParseInt(strings.TrimSpace(denominator), 10, 64)
+	if errN != nil || errD != nil || n <= 0 || d <= 0 || n > d {
+		return Ratio{}, ErrInvalidRatio
+	}
+	return Ratio{Numerator: n, Denominator: d}, nil
+}
+
+func (r Ratio) String() string {
+	return fmt.Sprintf("%d/%d", r.Numerator, r.Denominator)
+}
+
+func (r Ratio) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// Reached cek apakah part/whole mencapai ambang (>=)
+func (r Ratio) Reached(part, whole int64) bool {
+	return whole > 0 && part*r.Denominator >= r.Numerator*whole
+}
+
+// MotionResult - Keputusan sebuah pertanyaan referendum. Abstain tidak ikut pembagi ambang,
+// pemilihnya tetap dihitung hadir untuk quorum kecuali QuorumExcludesAbstain
+type MotionResult struct {
+	Support               int64  `json:"support"`
+	Against               int64  `json:"against"`
+	Abstain               int64  `json:"abstain"`
+	Voted                 int64  `json:"voted"`
+	Eligible              int64  `json:"eligible"`
+	Quorum                *Ratio `json:"quorum"`
+	QuorumExcludesAbstain bool   `json:"quorum_excludes_abstain"`
+	QuorumMet             bool   `json:"quorum_met"`
+	Threshold             *Ratio `json:"threshold"` // nil berarti mayoritas sederhana (lebih dari 1/2)
+	Passed                bool   `json:"passed"`
+}
+
+// DecideMotion - Tentukan apakah usulan diterima: quorum (voted/eligible) terpenuhi
+// dan support/(support+against) mencapai threshold.
+// Dengan excludeAbstain pemilih abstain dikurangkan dari voted sebelum quorum dicek
+func DecideMotion(support, against, abstain, voted, eligible int64, quorum, threshold *Ratio, excludeAbstain bool) MotionResult {
+	present := voted
+	if excludeAbstain {
+		present = max(voted-abstain, 0)
+	}
+
+	result := MotionResult{
+		Support:               support,
+		Against:               against,
+		Abstain:               abstain,
+		Voted:                 voted,
+		Eligible:              eligible,
+		Quorum:                quorum,
+		QuorumExcludesAbstain: excludeAbstain,
+		QuorumMet:             quorum == nil || quorum.Reached(present, eligible),
+		Threshold:             threshold,
+	}
+
+	if threshold == nil {
+		result.Passed = support*2 > support+against
+	} else {
+		result.Passed = threshold.Reached(support, support+against)
+	}
+	result.Passed = result.Passed && result.QuorumMet
+	return result
+}
@@ -0,0 +1,45 @@
+package tally
+
+import "fmt"
+
+// pluralityCounter - first-past-the-post, setiap pemilih memilih maksimal sebanyak kursi
+type pluralityCounter struct{}
+
+func (pluralityCounter) Validate(candidates []int, ballot Ballot, seats int) error {
+	if err := validateChoices(candidates, ballot); err != nil {
+		return err
+	}
+	if len(ballot.Choices) > seats {
+		return fmt.Errorf("tally: maksimal %d pilihan", seats)
+	}
+	return nil
+}
+
+func (pluralityCounter) Count(candidates []int, ballots []Ballot, seats int) Result {
+	return countMarks(candidates, ballots, seats)
+}
+
+// approvalCounter - pemilih boleh menyetujui candidate sebanyak apa pun
+type approvalCounter struct{}
+
+func (approvalCounter) Validate(candidates []int, ballot Ballot, seats int) error {
+	return validateChoices(candidates, ballot)
+}
+
+func (approvalCounter) Count(candidates []int, ballots []Ballot, seats int) Result {
+	return countMarks(candidates, ballots, seats)
+}
+
+// countMarks - setiap pilihan pada ballot bernilai suara sebesar bobot ballot
+func countMarks(candidates []int, ballots []Ballot, seats int) Result {
+	votes := emptyVotes(candidates)
+	for _, ballot := range ballots {
+		for _, choice := range ballot.Choices {
+			votes[choice] += ballot.weight()
+		}
+	}
+
+	scores := sortScores(votes)
+	winners, tied := pickWinners(scores, seats)
+	return Result{Scores: scores, Winners: winners, Tied: tied}
+}
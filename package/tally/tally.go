@@ -0,0 +1,269 @@
+package tally
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+type Method string
+
+const (
+	MethodPlurality Method = "plurality"
+	MethodApproval  Method = "approval"
+	MethodIRV       Method = "irv"
+	MethodBorda     Method = "borda"
+)
+
+var (
+	ErrUnknownMethod = errors.New("tally: metode penghitungan tidak dikenal")
+	ErrInvalidSeats  = errors.New("tally: jumlah kursi minimal 1")
+)
+
+// Ballot - Satu suara. Choices berisi id candidate sesuai urutan preferensi
+// (untuk plurality dan approval urutan tidak berpengaruh).
+// Weight adalah bobot suara pemilih, 0 dianggap 1.
+// Abstain menandai pemilih yang sengaja abstain dan Blank menandai suara kosong,
+// keduanya tanpa pilihan dan tidak ikut dihitung sebagai suara sah
+type Ballot struct {
+	Choices []int
+	Weight  int64
+	Abstain bool
+	Blank   bool
+}
+
+var ErrAbstainWithChoices = errors.New("tally: ballot abstain atau kosong tidak boleh berisi pilihan")
+
+// weight - bobot efektif ballot
+func (b Ballot) weight() int64 {
+	if b.Weight <= 0 {
+		return 1
+	}
+	return b.Weight
+}
+
+type Score struct {
+	CandidateID int   `json:"candidate_id"`
+	Votes       int64 `json:"votes"`
+}
+
+// Round - Rekap satu putaran penghitungan (dipakai instant-runoff)
+type Round struct {
+	Number     int     `json:"round"`
+	Scores     []Score `json:"scores"`
+	Exhausted  int64   `json:"exhausted"`
+	Eliminated []int   `json:"eliminated,omitempty"`
+}
+
+// Result - Hasil penghitungan. Tied berisi candidate dengan perolehan sama
+// pada batas kursi terakhir, kursi tersebut belum terisi di Winners.
+// Scores sudah dikalikan bobot ballot, jumlah ballot tetap dihitung per lembar.
+// TotalBallots = ValidBallots + BlankBallots + AbstainBallots + InvalidBallots (rusak)
+type Result struct {
+	Method         Method  `json:"method"`
+	Seats          int     `json:"seats"`
+	TotalBallots   int64   `json:"total_ballots"`
+	ValidBallots   int64   `json:"valid_ballots"`
+	BlankBallots   int64   `json:"blank_ballots"`
+	AbstainBallots int64   `json:"abstain_ballots"`
+	InvalidBallots int64   `json:"invalid_ballots"`
+	TotalWeight    int64   `json:"total_weight"`
+	ValidWeight    int64   `json:"valid_weight"`
+	BlankWeight    int64   `json:"blank_weight"`
+	AbstainWeight  int64   `json:"abstain_weight"`
+	Scores         []Score `json:"scores"`
+	Winners        []int   `json:"winners"`
+	Tied           []int   `json:"tied,omitempty"`
+	Rounds         []Round `json:"rounds,omitempty"`
+}
+
+// Counter - Kontrak setiap metode penghitungan.
+// Count hanya menerima ballot yang sudah lolos Validate
+type Counter interface {
+	Validate(candidates []int, ballot Ballot, seats int) error
+	Count(candidates []int, ballots []Ballot, seats int) Result
+}
+
+var counters = map[Method]Counter{
+	MethodPlurality: pluralityCounter{},
+	MethodApproval:  approvalCounter{},
+	MethodIRV:       irvCounter{},
+	MethodBorda:     bordaCounter{},
+}
+
+// Register mendaftarkan (atau mengganti) Counter untuk sebuah metode
+func Register(method Method, counter Counter) {
+	counters[method] = counter
+}
+
+// IsSupported cek apakah metode sudah punya Counter
+func IsSupported(method Method) bool {
+	_, ok := counters[method]
+	return ok
+}
+
+// Validate cek satu ballot terhadap aturan metode tanpa menghitung,
+// ballot abstain atau kosong hanya dicek tidak berisi pilihan
+func Validate(method Method, candidates []int, ballot Ballot, seats int) error {
+	counter, ok := counters[method]
+	if !ok {
+		return ErrUnknownMethod
+	}
+	if seats < 1 {
+		return ErrInvalidSeats
+	}
+	if ballot.Abstain || ballot.Blank {
+		return validateNoChoices(ballot)
+	}
+	return counter.Validate(candidates, ballot, seats)
+}
+
+// Count menghitung hasil. Ballot abstain dan kosong dicatat terpisah,
+// ballot yang tidak valid (rusak) tidak ikut dihitung dan dicatat di InvalidBallots
+func Count(method Method, candidates []int, ballots []Ballot, seats int) (Result, error) {
+	counter, ok := counters[method]
+	if !ok {
+		return Result{}, ErrUnknownMethod
+	}
+	if seats < 1 {
+		return Result{}, ErrInvalidSeats
+	}
+
+	valid := make([]Ballot, 0, len(ballots))
+	var totalWeight, validWeight, blankWeight, abstainWeight int64
+	var blank, abstain, invalid int64
+	for _, ballot := range ballots {
+		totalWeight += ballot.weight()
+		switch {
+		case (ballot.Abstain || ballot.Blank) && validateNoChoices(ballot) != nil:
+			invalid++
+		case ballot.Abstain:
+			abstain++
+			abstainWeight += ballot.weight()
+		case ballot.Blank:
+			blank++
+			blankWeight += ballot.weight()
+		case counter.Validate(candidates, ballot, seats) == nil:
+			valid = append(valid, ballot)
+			validWeight += ballot.weight()
+		default:
+			invalid++
+		}
+	}
+
+	result := counter.Count(candidates, valid, seats)
+	result.Method = method
+	result.Seats = seats
+	result.TotalBallots = int64(len(ballots))
+	result.ValidBallots = int64(len(valid))
+	result.BlankBallots = blank
+	result.AbstainBallots = abstain
+	result.InvalidBallots = invalid
+	result.TotalWeight = totalWeight
+	result.ValidWeight = validWeight
+	result.BlankWeight = blankWeight
+	result.AbstainWeight = abstainWeight
+	return result, nil
+}
+
+// validateNoChoices - ballot abstain atau kosong tidak boleh berisi pilihan dan tidak boleh sekaligus keduanya
+func validateNoChoices(ballot Ballot) error {
+	if len(ballot.Choices) > 0 || (ballot.Abstain && ballot.Blank) {
+		return ErrAbstainWithChoices
+	}
+	return nil
+}
+
+// validateChoices - aturan dasar: minimal satu pilihan, candidate terdaftar, tidak ada duplikat
+func validateChoices(candidates []int, ballot Ballot) error {
+	if len(ballot.Choices) == 0 {
+		return errors.New("tally: ballot tidak memiliki pilihan")
+	}
+	seen := make(map[int]bool, len(ballot.Choices))
+	for _, choice := range ballot.Choices {
+		if !slices.Contains(candidates, choice) {
+			return fmt.Errorf("tally: candidate %d tidak terdaftar", choice)
+		}
+		if seen[choice] {
+			return fmt.Errorf("tally: candidate %d dipilih lebih dari sekali", choice)
+		}
+		seen[choice] = true
+	}
+	return nil
+}
+
+// sortScores - urutkan perolehan terbesar dulu, jika sama id candidate terkecil dulu
+func sortScores(votes map[int]int64) []Score {
+	scores := make([]Score, 0, len(votes))
+	for candidateID, total := range votes {
+		scores = append(scores, Score{CandidateID: candidateID, Votes: total})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Votes != scores[j].Votes {
+			return scores[i].Votes > scores[j].Votes
+		}
+		return scores[i].CandidateID < scores[j].CandidateID
+	})
+	return scores
+}
+
+// pickWinners - ambil sejumlah seats teratas. Jika batas kursi terakhir seri,
+// candidate yang seri dikembalikan di tied dan kursinya dibiarkan kosong
+func pickWinners(scores []Score, seats int) (winners []int, tied []int) {
+	winners = []int{}
+	if len(scores) <= seats {
+		for _, score := range scores {
+			winners = append(winners, score.CandidateID)
+		}
+		return
+	}
+
+	cutoff := scores[seats-1].Votes
+	if scores[seats].Votes != cutoff {
+		for _, score := range scores[:seats] {
+			winners = append(winners, score.CandidateID)
+		}
+		return
+	}
+
+	for _, score := range scores {
+		switch {
+		case score.Votes > cutoff:
+			winners = append(winners, score.CandidateID)
+		case score.Votes == cutoff:
+			tied = append(tied, score.CandidateID)
+		}
+	}
+	return
+}
+
+func emptyVotes(candidates []int) map[int]int64 {
+	votes := make(map[int]int64, len(candidates))
+	for _, candidateID := range candidates {
+		votes[candidateID] = 0
+	}
+	return votes
+}
+
+// BreakTie - Isi kursi yang kosong karena seri sesuai urutan prioritas candidate yang seri (index kecil didahulukan).
+// Candidate di priority yang tidak termasuk Tied diabaikan, Tied tetap dipertahankan sebagai catatan
+func BreakTie(result Result, priority []int) Result {
+	remaining := result.Seats - len(result.Winners)
+	if remaining <= 0 || len(result.Tied) == 0 {
+		return result
+	}
+
+	winners := slices.Clone(result.Winners)
+	for _, candidateID := range priority {
+		if remaining == 0 {
+			break
+		}
+		if slices.Contains(result.Tied, candidateID) && !slices.Contains(winners, candidateID) {
+			winners = append(winners, candidateID)
+			remaining--
+		}
+	}
+	result.Winners = winners
+	return result
+}
@@ -0,0 +1,483 @@
+package tally
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func ballots(choices ...[]int) []Ballot {
+	result := make([]Ballot, 0, len(choices))
+	for _, c := range choices {
+		result = append(result, Ballot{Choices: c})
+	}
+	return result
+}
+
+func repeat(n int, choices []int) [][]int {
+	result := make([][]int, n)
+	for i := range result {
+		result[i] = choices
+	}
+	return result
+}
+
+func join(groups ...[][]int) []Ballot {
+	var all [][]int
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	return ballots(all...)
+}
+
+func TestCount(t *testing.T) {
+	candidates := []int{1, 2, 3, 4}
+
+	tests := []struct {
+		name        string
+		method      Method
+		seats       int
+		ballots     []Ballot
+		wantWinners []int
+		wantTied    []int
+		wantScores  []Score
+		wantInvalid int64
+		wantRounds  int
+	}{
+		{
+			name:        "plurality single winner",
+			method:      MethodPlurality,
+			seats:       1,
+			ballots:     ballots([]int{1}, []int{2}, []int{1}, []int{3}),
+			wantWinners: []int{1},
+			wantScores:  []Score{{1, 2}, {2, 1}, {3, 1}, {4, 0}},
+		},
+		{
+			name:        "plurality tie at cutoff",
+			method:      MethodPlurality,
+			seats:       1,
+			ballots:     ballots([]int{1}, []int{2}),
+			wantWinners: []int{},
+			wantTied:    []int{1, 2},
+			wantScores:  []Score{{1, 1}, {2, 1}, {3, 0}, {4, 0}},
+		},
+		{
+			name:        "plurality rejects too many choices and unknown candidates",
+			method:      MethodPlurality,
+			seats:       1,
+			ballots:     ballots([]int{1, 2}, []int{9}, []int{}, []int{3}),
+			wantWinners: []int{3},
+			wantScores:  []Score{{3, 1}, {1, 0}, {2, 0}, {4, 0}},
+			wantInvalid: 3,
+		},
+		{
+			name:        "plurality multi seat",
+			method:      MethodPlurality,
+			seats:       2,
+			ballots:     ballots([]int{1, 2}, []int{1, 3}, []int{2}),
+			wantWinners: []int{1, 2},
+			wantScores:  []Score{{1, 2}, {2, 2}, {3, 1}, {4, 0}},
+		},
+		{
+			name:        "approval counts every approved candidate",
+			method:      MethodApproval,
+			seats:       1,
+			ballots:     ballots([]int{1, 2}, []int{2, 3}, []int{2}, []int{1, 1}),
+			wantWinners: []int{2},
+			wantScores:  []Score{{2, 3}, {1, 1}, {3, 1}, {4, 0}},
+			wantInvalid: 1,
+		},
+		{
+			name:        "borda points by rank",
+			method:      MethodBorda,
+			seats:       1,
+			ballots:     ballots([]int{1, 2, 3, 4}, []int{2, 3, 1, 4}, []int{2, 1}),
+			wantWinners: []int{2},
+			wantScores:  []Score{{2, 8}, {1, 6}, {3, 3}, {4, 0}},
+		},
+		{
+			name:        "irv majority in first round",
+			method:      MethodIRV,
+			seats:       1,
+			ballots:     ballots([]int{1, 2}, []int{1}, []int{2, 1}),
+			wantWinners: []int{1},
+			wantScores:  []Score{{1, 2}, {2, 1}, {3, 0}, {4, 0}},
+			wantRounds:  1,
+		},
+		{
+			name:   "irv transfers after elimination",
+			method: MethodIRV,
+			seats:  1,
+			ballots: join(
+				repeat(4, []int{1}),
+				repeat(3, []int{2, 3}),
+				repeat(2, []int{3, 2}),
+				repeat(1, []int{4, 3, 2}),
+			),
+			// putaran 1: 4 dieliminasi, putaran 2: 3 dieliminasi, putaran 3: 2 mayoritas
+			wantWinners: []int{2},
+			wantScores:  []Score{{2, 6}, {1, 4}},
+			wantRounds:  3,
+		},
+		{
+			name:        "irv final tie is reported",
+			method:      MethodIRV,
+			seats:       1,
+			ballots:     ballots([]int{1}, []int{2}, []int{1, 2}, []int{2, 1}),
+			wantWinners: []int{},
+			wantTied:    []int{1, 2},
+			wantScores:  []Score{{1, 2}, {2, 2}},
+			wantRounds:  2,
+		},
+		{
+			name:        "irv without ballots ties everyone",
+			method:      MethodIRV,
+			seats:       1,
+			ballots:     nil,
+			wantWinners: []int{},
+			wantTied:    []int{1, 2, 3, 4},
+			wantScores:  []Score{{1, 0}, {2, 0}, {3, 0}, {4, 0}},
+			wantRounds:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Count(tt.method, candidates, tt.ballots, tt.seats)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Winners, tt.wantWinners) {
+				t.Errorf("winners = %v, want %v", result.Winners, tt.wantWinners)
+			}
+			if !reflect.DeepEqual(result.Tied, tt.wantTied) {
+				t.Errorf("tied = %v, want %v", result.Tied, tt.wantTied)
+			}
+			if !reflect.DeepEqual(result.Scores, tt.wantScores) {
+				t.Errorf("scores = %v, want %v", result.Scores, tt.wantScores)
+			}
+			if result.InvalidBallots != tt.wantInvalid {
+				t.Errorf("invalid = %d, want %d", result.InvalidBallots, tt.wantInvalid)
+			}
+			if len(result.Rounds) != tt.wantRounds {
+				t.Errorf("rounds = %d, want %d", len(result.Rounds), tt.wantRounds)
+			}
+			if result.TotalBallots != int64(len(tt.ballots)) {
+				t.Errorf("total = %d, want %d", result.TotalBallots, len(tt.ballots))
+			}
+		})
+	}
+}
+
+func TestIRVRounds(t *testing.T) {
+	result, err := Count(MethodIRV, []int{1, 2, 3}, ballots(
+		[]int{1}, []int{1}, []int{2, 3}, []int{2}, []int{3, 2}, []int{3},
+	), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Round{
+		{Number: 1, Scores: []Score{{1, 2}, {2, 2}, {3, 2}}},
+		// semua seri di posisi terendah, eliminasi semua akan mengosongkan kursi
+	}
+	if !reflect.DeepEqual(result.Rounds, want) {
+		t.Errorf("rounds = %+v, want %+v", result.Rounds, want)
+	}
+	if !reflect.DeepEqual(result.Tied, []int{1, 2, 3}) {
+		t.Errorf("tied = %v", result.Tied)
+	}
+
+	result, err = Count(MethodIRV, []int{1, 2, 3}, ballots(
+		[]int{1}, []int{1}, []int{1}, []int{2, 3}, []int{2}, []int{3},
+	), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []Round{
+		{Number: 1, Scores: []Score{{1, 3}, {2, 2}, {3, 1}}, Eliminated: []int{3}},
+		{Number: 2, Scores: []Score{{1, 3}, {2, 2}}, Exhausted: 1},
+	}
+	if !reflect.DeepEqual(result.Rounds, want) {
+		t.Errorf("rounds = %+v, want %+v", result.Rounds, want)
+	}
+	if !reflect.DeepEqual(result.Winners, []int{1}) {
+		t.Errorf("winners = %v, want [1]", result.Winners)
+	}
+}
+
+func TestCountWeighted(t *testing.T) {
+	candidates := []int{1, 2, 3}
+
+	tests := []struct {
+		name        string
+		method      Method
+		ballots     []Ballot
+		wantWinners []int
+		wantScores  []Score
+		wantTotal   int64
+		wantValid   int64
+	}{
+		{
+			name:   "plurality weight outvotes headcount",
+			method: MethodPlurality,
+			ballots: []Ballot{
+				{Choices: []int{1}, Weight: 10},
+				{Choices: []int{2}, Weight: 3},
+				{Choices: []int{2}, Weight: 3},
+				{Choices: []int{2, 3}, Weight: 50},
+			},
+			wantWinners: []int{1},
+			wantScores:  []Score{{1, 10}, {2, 6}, {3, 0}},
+			wantTotal:   66,
+			wantValid:   16,
+		},
+		{
+			name:   "zero weight counts as one",
+			method: MethodApproval,
+			ballots: []Ballot{
+				{Choices: []int{1, 2}},
+				{Choices: []int{2}, Weight: 2},
+			},
+			wantWinners: []int{2},
+			wantScores:  []Score{{2, 3}, {1, 1}, {3, 0}},
+			wantTotal:   3,
+			wantValid:   3,
+		},
+		{
+			name:   "borda points are multiplied",
+			method: MethodBorda,
+			ballots: []Ballot{
+				{Choices: []int{1, 2, 3}, Weight: 1},
+				{Choices: []int{3, 2, 1}, Weight: 4},
+			},
+			wantWinners: []int{3},
+			wantScores:  []Score{{3, 8}, {2, 5}, {1, 2}},
+			wantTotal:   5,
+			wantValid:   5,
+		},
+		{
+			name:   "irv majority is weighted",
+			method: MethodIRV,
+			ballots: []Ballot{
+				{Choices: []int{1}, Weight: 5},
+				{Choices: []int{2, 1}, Weight: 1},
+				{Choices: []int{2}, Weight: 1},
+				{Choices: []int{3}, Weight: 1},
+			},
+			wantWinners: []int{1},
+			wantScores:  []Score{{1, 5}, {2, 2}, {3, 1}},
+			wantTotal:   8,
+			wantValid:   8,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Count(tt.method, candidates, tt.ballots, 1)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Winners, tt.wantWinners) {
+				t.Errorf("winners = %v, want %v", result.Winners, tt.wantWinners)
+			}
+			if !reflect.DeepEqual(result.Scores, tt.wantScores) {
+				t.Errorf("scores = %v, want %v", result.Scores, tt.wantScores)
+			}
+			if result.TotalWeight != tt.wantTotal {
+				t.Errorf("total weight = %d, want %d", result.TotalWeight, tt.wantTotal)
+			}
+			if result.ValidWeight != tt.wantValid {
+				t.Errorf("valid weight = %d, want %d", result.ValidWeight, tt.wantValid)
+			}
+			if result.TotalBallots != int64(len(tt.ballots)) {
+				t.Errorf("total = %d, want %d", result.TotalBallots, len(tt.ballots))
+			}
+		})
+	}
+}
+
+func TestCountBlankAndAbstain(t *testing.T) {
+	candidates := []int{1, 2}
+	ballots := []Ballot{
+		{Choices: []int{1}, Weight: 2},
+		{Choices: []int{2}},
+		{Abstain: true, Weight: 3},
+		{Abstain: true},
+		{Blank: true, Weight: 4},
+		{Choices: []int{9}},
+		{Abstain: true, Choices: []int{1}},
+		{Abstain: true, Blank: true},
+		{},
+	}
+
+	result, err := Count(MethodPlurality, candidates, ballots, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := []int64{result.TotalBallots, result.ValidBallots, result.BlankBallots, result.AbstainBallots, result.InvalidBallots}
+	want := []int64{9, 2, 1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("total/valid/blank/abstain/invalid = %v, want %v", got, want)
+	}
+	gotWeight := []int64{result.TotalWeight, result.ValidWeight, result.BlankWeight, result.AbstainWeight}
+	wantWeight := []int64{15, 3, 4, 4}
+	if !reflect.DeepEqual(gotWeight, wantWeight) {
+		t.Errorf("total/valid/blank/abstain weight = %v, want %v", gotWeight, wantWeight)
+	}
+	if !reflect.DeepEqual(result.Scores, []Score{{1, 2}, {2, 1}}) {
+		t.Errorf("scores = %v", result.Scores)
+	}
+}
+
+func TestValidateAbstain(t *testing.T) {
+	if err := Validate(MethodIRV, []int{1, 2}, Ballot{Abstain: true}, 1); err != nil {
+		t.Errorf("abstain tanpa pilihan harus valid: %v", err)
+	}
+	if err := Validate(MethodApproval, []int{1, 2}, Ballot{Blank: true}, 1); err != nil {
+		t.Errorf("suara kosong tanpa pilihan harus valid: %v", err)
+	}
+	if err := Validate(MethodPlurality, []int{1, 2}, Ballot{Abstain: true, Choices: []int{1}}, 1); !errors.Is(err, ErrAbstainWithChoices) {
+		t.Errorf("err = %v, want %v", err, ErrAbstainWithChoices)
+	}
+	if err := Validate(MethodPlurality, []int{1, 2}, Ballot{}, 1); err == nil {
+		t.Error("ballot tanpa pilihan dan tanpa tanda abstain/kosong harus tidak valid")
+	}
+}
+
+func TestBreakTie(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      Result
+		priority    []int
+		wantWinners []int
+	}{
+		{
+			name:        "one seat among three tied",
+			result:      Result{Seats: 1, Winners: []int{}, Tied: []int{2, 3, 4}},
+			priority:    []int{4, 2, 3},
+			wantWinners: []int{4},
+		},
+		{
+			name:        "keeps outright winners",
+			result:      Result{Seats: 3, Winners: []int{1}, Tied: []int{2, 3, 4}},
+			priority:    []int{3, 4, 2},
+			wantWinners: []int{1, 3, 4},
+		},
+		{
+			name:        "ignores candidates outside the tie",
+			result:      Result{Seats: 2, Winners: []int{1}, Tied: []int{2, 3}},
+			priority:    []int{1, 5, 3, 2},
+			wantWinners: []int{1, 3},
+		},
+		{
+			name:        "no tie leaves result untouched",
+			result:      Result{Seats: 1, Winners: []int{1}},
+			priority:    []int{2},
+			wantWinners: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BreakTie(tt.result, tt.priority)
+			if !reflect.DeepEqual(got.Winners, tt.wantWinners) {
+				t.Errorf("winners = %v, want %v", got.Winners, tt.wantWinners)
+			}
+			if !reflect.DeepEqual(got.Tied, tt.result.Tied) {
+				t.Errorf("tied = %v, want %v", got.Tied, tt.result.Tied)
+			}
+		})
+	}
+}
+
+func TestCountErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  Method
+		seats   int
+		wantErr error
+	}{
+		{name: "unknown method", method: "condorcet", seats: 1, wantErr: ErrUnknownMethod},
+		{name: "zero seats", method: MethodPlurality, seats: 0, wantErr: ErrInvalidSeats},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Count(tt.method, []int{1}, nil, tt.seats)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecideMotion(t *testing.T) {
+	twoThirds := &Ratio{Numerator: 2, Denominator: 3}
+	half := &Ratio{Numerator: 1, Denominator: 2}
+
+	tests := []struct {
+		name          string
+		support       int64
+		against       int64
+		abstain       int64
+		voted         int64
+		eligible      int64
+		quorum        *Ratio
+		threshold     *Ratio
+		excludeAbst   bool
+		wantQuorumMet bool
+		wantPassed    bool
+	}{
+		{name: "simple majority passes", support: 6, against: 4, voted: 10, eligible: 20, wantQuorumMet: true, wantPassed: true},
+		{name: "simple majority tie fails", support: 5, against: 5, voted: 10, eligible: 20, wantQuorumMet: true},
+		{name: "abstain excluded from threshold", support: 3, against: 2, abstain: 10, voted: 15, eligible: 20, wantQuorumMet: true, wantPassed: true},
+		{name: "exactly two thirds passes", support: 20, against: 10, voted: 30, eligible: 30, threshold: twoThirds, wantQuorumMet: true, wantPassed: true},
+		{name: "below two thirds fails", support: 19, against: 10, voted: 29, eligible: 30, threshold: twoThirds, wantQuorumMet: true},
+		{name: "quorum not met", support: 9, against: 0, voted: 9, eligible: 20, quorum: half},
+		{name: "quorum met by abstentions", support: 2, against: 1, abstain: 7, voted: 10, eligible: 20, quorum: half, wantQuorumMet: true, wantPassed: true},
+		{name: "no eligible voters", quorum: half},
+		{name: "no votes", voted: 0, eligible: 10, wantQuorumMet: true},
+		{name: "quorum excluding abstentions not met", support: 2, against: 1, abstain: 7, voted: 10, eligible: 20, quorum: half, excludeAbst: true},
+		{name: "quorum excluding abstentions met", support: 8, against: 2, abstain: 3, voted: 13, eligible: 20, quorum: half, excludeAbst: true, wantQuorumMet: true, wantPassed: true},
+		{name: "abstain larger than voted", abstain: 5, voted: 3, eligible: 10, quorum: half, excludeAbst: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecideMotion(tt.support, tt.against, tt.abstain, tt.voted, tt.eligible, tt.quorum, tt.threshold, tt.excludeAbst)
+			if got.QuorumMet != tt.wantQuorumMet || got.Passed != tt.wantPassed {
+				t.Fatalf("DecideMotion() quorum_met=%v passed=%v, want %v %v", got.QuorumMet, got.Passed, tt.wantQuorumMet, tt.wantPassed)
+			}
+		})
+	}
+}
+
+func TestParseRatio(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Ratio
+		wantErr bool
+	}{
+		{value: "2/3", want: Ratio{2, 3}},
+		{value: " 1 / 2 ", want: Ratio{1, 2}},
+		{value: "1/1", want: Ratio{1, 1}},
+		{value: "3/2", wantErr: true},
+		{value: "0/3", wantErr: true},
+		{value: "0.66", wantErr: true},
+		{value: "a/b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRatio(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRatio(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Fatalf("ParseRatio(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -99,7 +99,7 @@ func (h *AuthHandler) RefreshToken(c fiber.Ctx) error {
 		return helper.SendErrorResponse(c, fiber.StatusBadRequest, "Invalid request body", err)
 	}
 
-	res, sysErr := h.AuthUsecase.RefreshToken(req.RefreshToken, req.OldAccessToken)
+	res, sysErr := h.AuthUsecase.RefreshToken(c, req.RefreshToken, req.OldAccessToken)
 	if sysErr != nil {
 		return helper.SendErrorResponse(c, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
 	}
@@ -72,15 +72,21 @@ func (u *AuthUsecase) Login(ctx fiber.Ctx, req dto.LoginRequest, deviceID string
 		return
 	}
 
+	// Role token diambil dari users_has_roles, bukan lagi selalu "user"
+	role, sysError := u.roleUse.GetTokenRole(ctx, userRes.ID)
+	if sysError != nil {
+		return
+	}
+
 	// 🆕 Generate access token dengan userID dan deviceID
-	accessToken, tokenErr := middleware.GenerateTokenHS256(userRes.ID, "user", deviceID)
+	accessToken, tokenErr := middleware.GenerateTokenHS256(userRes.ID, role, deviceID)
 	if tokenErr != nil {
 		sysError = syserror.CreateError(tokenErr, fiber.StatusInternalServerError, "Gagal generate token")
 		return
 	}
 
 	// 🆕 Generate refresh token dengan userID dan deviceID
-	refreshToken, refreshErr := middleware.GenerateRefreshToken(userRes.ID, "user", deviceID)
+	refreshToken, refreshErr := middleware.GenerateRefreshToken(userRes.ID, role, deviceID)
 	if refreshErr != nil {
 		sysError = syserror.CreateError(refreshErr, fiber.StatusInternalServerError, "Gagal generate refresh token")
 		return
@@ -118,15 +124,21 @@ func (u *AuthUsecase) Register(ctx fiber.Ctx, req dto.RegisterRequest, deviceID
 		return
 	}
 
+	// Role token diambil dari users_has_roles, bukan lagi selalu "user"
+	role, sysError := u.roleUse.GetTokenRole(ctx, userRes.ID)
+	if sysError != nil {
+		return
+	}
+
 	// 🆕 Generate access token dengan userID dan deviceID
-	accessToken, tokenErr := middleware.GenerateTokenHS256(userRes.ID, "user", deviceID)
+	accessToken, tokenErr := middleware.GenerateTokenHS256(userRes.ID, role, deviceID)
 	if tokenErr != nil {
 		sysError = syserror.CreateError(tokenErr, fiber.StatusInternalServerError, "Gagal generate token")
 		return
 	}
 
 	// 🆕 Generate refresh token dengan userID dan deviceID
-	refreshToken, refreshErr := middleware.GenerateRefreshToken(userRes.ID, "user", deviceID)
+	refreshToken, refreshErr := middleware.GenerateRefreshToken(userRes.ID, role, deviceID)
 	if refreshErr != nil {
 		sysError = syserror.CreateError(refreshErr, fiber.StatusInternalServerError, "Gagal generate refresh token")
 		return
@@ -147,7 +159,8 @@ func (u *AuthUsecase) Register(ctx fiber.Ctx, req dto.RegisterRequest, deviceID
 
 // RefreshToken - Generate new access token dari refresh token
 // oldAccessToken = old access token yang ingin di-blacklist (optional)
-func (u *AuthUsecase) RefreshToken(tokenStr string, oldAccessToken string) (res middleware.GenerateTokenRes, sysError syserror.SysError) {
+// Role diambil ulang dari database supaya perubahan role (misal ditunjuk jadi saksi) ikut ke token baru
+func (u *AuthUsecase) RefreshToken(ctx fiber.Ctx, tokenStr string, oldAccessToken string) (res middleware.GenerateTokenRes, sysError syserror.SysError) {
 	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
 		return []byte(config.AppConfig.JwtSecret + "_refresh"), nil
 	})
@@ -219,7 +232,10 @@ func (u *AuthUsecase) RefreshToken(tokenStr string, oldAccessToken string) (res
 	}
 
 	// Generate new access token dengan userID dan deviceID yang sama
-	role, _ := claims["role"].(string)
+	role, sysError := u.roleUse.GetTokenRole(ctx, int(userID))
+	if sysError != nil {
+		return
+	}
 	res, tokenErr := middleware.GenerateTokenHS256(int(userID), role, deviceID)
 	if tokenErr != nil {
 		sysError = syserror.CreateError(tokenErr, fiber.StatusInternalServerError, "Gagal generate token")
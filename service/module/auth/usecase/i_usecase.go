@@ -6,18 +6,21 @@ import (
 	"github.com/madmuzz05/be-enyoblos/package/middleware"
 	"github.com/madmuzz05/be-enyoblos/package/redisdb"
 	"github.com/madmuzz05/be-enyoblos/service/module/auth/dto"
+	roleUsecase "github.com/madmuzz05/be-enyoblos/service/module/role/usecase"
 	"github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
 )
 
 type AuthUsecase struct {
 	redisDb     *redisdb.RedisClient
 	userUsecase usecase.IUserUsecase
+	roleUse     roleUsecase.IRoleUsecase
 }
 
-func InitAuthUsecase(redisDb *redisdb.RedisClient, userUsecase usecase.IUserUsecase) IAuthUsecase {
+func InitAuthUsecase(redisDb *redisdb.RedisClient, userUsecase usecase.IUserUsecase, roleUse roleUsecase.IRoleUsecase) IAuthUsecase {
 	return &AuthUsecase{
 		redisDb:     redisDb,
 		userUsecase: userUsecase,
+		roleUse:     roleUse,
 	}
 }
 
@@ -25,7 +28,7 @@ type IAuthUsecase interface {
 	Login(ctx fiber.Ctx, req dto.LoginRequest, deviceID string) (res dto.AuthResponse, sysError syserror.SysError)
 	Register(ctx fiber.Ctx, req dto.RegisterRequest, deviceID string) (res dto.AuthResponse, sysError syserror.SysError)
 	Logout(tokenStr string) (sysError syserror.SysError)
-	RefreshToken(tokenStr string, oldAccessToken string) (res middleware.GenerateTokenRes, sysError syserror.SysError)
+	RefreshToken(ctx fiber.Ctx, tokenStr string, oldAccessToken string) (res middleware.GenerateTokenRes, sysError syserror.SysError)
 	RevokeAllTokens(userID int) (sysError syserror.SysError)
 	RevokeDeviceTokens(userID int, deviceID string) (sysError syserror.SysError)
 }
@@ -0,0 +1,162 @@
+package dto
+
+import (
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	candidateEntity "github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+)
+
+// CastBallotRequest - DTO untuk memberikan suara, wajib berisi pilihan untuk setiap contest election.
+// DelegationID diisi proxy yang memberikan suara atas nama delegator
+type CastBallotRequest struct {
+	Contests     []ContestChoiceRequest `json:"contests" validate:"required,min=1,dive"`
+	DelegationID *int                   `json:"delegation_id" validate:"omitempty,gt=0"`
+	RevoteToken  string                 `json:"revote_token" validate:"omitempty,max=64"` // dari bukti suara terakhir, wajib untuk memilih ulang
+}
+
+// ContestChoiceRequest - Pilihan pada satu contest; untuk irv dan borda urutan choices adalah peringkat.
+// abstain true tanpa choices berarti pemilih sengaja abstain, choices kosong tanpa abstain berarti suara kosong
+type ContestChoiceRequest struct {
+	ContestID int   `json:"contest_id" validate:"required"`
+	Choices   []int `json:"choices" validate:"omitempty,dive,required"`
+	Abstain   bool  `json:"abstain"`
+}
+
+// BallotReceiptResponse - Bukti bahwa suara sudah tercatat, disimpan oleh pemilih
+type BallotReceiptResponse struct {
+	ElectionID  int               `json:"election_id"`
+	ReceiptCode string            `json:"receipt_code"`
+	Sequence    int               `json:"sequence"`
+	Hash        string            `json:"hash"`
+	CastAt      helper.CustomTime `json:"cast_at"`
+	RevoteToken string            `json:"revote_token,omitempty"` // hanya pada election dengan pemungutan ulang, tidak bisa diminta ulang
+}
+
+// ReceiptVerificationResponse - Hasil pengecekan kode bukti pada rantai ballot
+// Isi suara tidak ikut ditampilkan supaya kode bukti tidak bisa dipakai jual-beli suara,
+// begitu juga status digantikan supaya pemaksa yang memegang kode bukti tidak tahu pemilih sudah memilih ulang
+type ReceiptVerificationResponse struct {
+	ElectionID    int    `json:"election_id"`
+	ReceiptCode   string `json:"receipt_code"`
+	Included      bool   `json:"included"`
+	Sequence      int    `json:"sequence"`
+	PrevHash      string `json:"prev_hash"`
+	Hash          string `json:"hash"`
+	HashValid     bool   `json:"hash_valid"`
+	ChainLength   int    `json:"chain_length"`
+	ChainHeadHash string `json:"chain_head_hash"`
+}
+
+// ChainAuditResponse - Hasil menelusuri ulang rantai hash sebuah election
+type ChainAuditResponse struct {
+	ElectionID        int    `json:"election_id"`
+	Valid             bool   `json:"valid"`
+	TotalBallots      int    `json:"total_ballots"`
+	SupersededBallots int    `json:"superseded_ballots"`
+	HeadSequence      int    `json:"head_sequence"`
+	HeadHash          string `json:"head_hash"`
+	BrokenAtSequence  *int   `json:"broken_at_sequence"`
+	Reason            string `json:"reason,omitempty"`
+}
+
+// TurnoutResponse - Ringkasan partisipasi pemilih, per orang dan per bobot suara.
+// Tanpa bobot khusus nilai bobot sama dengan jumlah orang.
+// Pemilih yang abstain atau memberi suara kosong tetap dihitung sudah memilih,
+// rinciannya per contest (Contests) hanya terisi setelah hasil boleh dilihat
+type TurnoutResponse struct {
+	ElectionID          int              `db:"-" json:"election_id"`
+	TotalEligible       int64            `db:"total_eligible" json:"total_eligible"`
+	TotalVoted          int64            `db:"total_voted" json:"total_voted"`
+	TurnoutPercentage   float64          `db:"-" json:"turnout_percentage"`
+	TotalEligibleWeight int64            `db:"total_eligible_weight" json:"total_eligible_weight"`
+	TotalVotedWeight    int64            `db:"total_voted_weight" json:"total_voted_weight"`
+	WeightPercentage    float64          `db:"-" json:"weight_percentage"`
+	Contests            []ContestTurnout `db:"-" json:"contests,omitempty"`
+}
+
+// ContestTurnout - Rincian ballot satu contest: sah, kosong, abstain, dan rusak (tidak valid)
+type ContestTurnout struct {
+	ContestID      int    `json:"contest_id"`
+	Name           string `json:"name"`
+	ValidBallots   int64  `json:"valid_ballots"`
+	BlankBallots   int64  `json:"blank_ballots"`
+	AbstainBallots int64  `json:"abstain_ballots"`
+	InvalidBallots int64  `json:"invalid_ballots"`
+}
+
+// StreamTokenResponse - Stream token berumur pendek untuk membuka stream live lewat ?stream_token=
+type StreamTokenResponse struct {
+	ElectionID  int               `json:"election_id"`
+	StreamToken string            `json:"stream_token"`
+	ExpiresAt   helper.CustomTime `json:"expires_at"`
+}
+
+// LiveUpdate - Data yang dikirim ke stream live election, results hanya terisi setelah election ditutup
+type LiveUpdate struct {
+	ElectionID int                     `json:"election_id"`
+	Status     string                  `json:"status"`
+	Turnout    TurnoutResponse         `json:"turnout"`
+	Results    *ElectionResultResponse `json:"results,omitempty"`
+	UpdatedAt  helper.CustomTime       `json:"updated_at"`
+}
+
+// VoterParticipation - Status partisipasi per pemilih DPT (tanpa isi suara)
+type VoterParticipation struct {
+	VoterRollID  int     `db:"voter_roll_id" json:"voter_roll_id"`
+	UserID       *int    `db:"user_id" json:"user_id"`
+	Name         string  `db:"name" json:"name"`
+	Email        string  `db:"email" json:"email"`
+	MemberNumber *string `db:"member_number" json:"member_number"`
+	Weight       int     `db:"weight" json:"weight"`
+	HasVoted     bool    `db:"has_voted" json:"has_voted"`
+}
+
+// ElectionResultResponse - Hasil penghitungan suara per contest.
+// TotalBallots hanya ballot yang dihitung, ballot yang digantikan pemungutan ulang dilaporkan terpisah
+type ElectionResultResponse struct {
+	ElectionID        int             `json:"election_id"`
+	Status            string          `json:"status"`
+	TotalBallots      int             `json:"total_ballots"`
+	SupersededBallots int             `json:"superseded_ballots"`
+	Turnout           TurnoutResponse `json:"turnout"`
+	Contests          []ContestResult `json:"contests"`
+}
+
+// ContestResult - Hasil satu contest sesuai metode dan jumlah kursinya.
+// Motion hanya terisi untuk pertanyaan referendum, TieBreak hanya jika ada seri pada batas kursi terakhir
+type ContestResult struct {
+	Contest    contestEntity.Contest       `json:"contest"`
+	Candidates []candidateEntity.Candidate `json:"candidates"`
+	Result     tally.Result                `json:"result"`
+	Motion     *tally.MotionResult         `json:"motion,omitempty"`
+	TieBreak   *TieBreakResult             `json:"tie_break,omitempty"`
+}
+
+// TieBreakResult - Penyelesaian seri sebuah contest. Order adalah urutan prioritas candidate yang seri,
+// Seed hanya untuk lottery dan bisa dipakai siapa pun untuk mengulang undian.
+// Pending berarti kursi menunggu election runoff
+type TieBreakResult struct {
+	Policy  string `json:"policy"`
+	Tied    []int  `json:"tied"`
+	Seats   int    `json:"seats"`
+	Seed    string `json:"seed,omitempty"`
+	Order   []int  `json:"order,omitempty"`
+	Elected []int  `json:"elected"`
+	Pending bool   `json:"pending"`
+}
+
+// BallotPaperResponse - Surat suara untuk satu pemilih, urutan candidate sesuai candidate_order election.
+// Pilihan tetap dikirim sebagai id candidate sehingga urutan tampilan tidak memengaruhi isi suara
+type BallotPaperResponse struct {
+	ElectionID     int            `json:"election_id"`
+	Title          string         `json:"title"`
+	CandidateOrder string         `json:"candidate_order"`
+	Contests       []ContestPaper `json:"contests"`
+}
+
+// ContestPaper - Satu contest pada surat suara beserta candidate dalam urutan tampil
+type ContestPaper struct {
+	Contest    contestEntity.Contest       `json:"contest"`
+	Candidates []candidateEntity.Candidate `json:"candidates"`
+}
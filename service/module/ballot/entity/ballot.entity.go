@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// GenesisHash - prev_hash untuk ballot pertama pada rantai setiap election
+var GenesisHash = strings.Repeat("0", 64)
+
+// Ballot - Isi suara. Sengaja tidak memiliki user_id maupun waktu,
+// sehingga tidak ada cara men-join isi suara dengan identitas pemilih.
+// Setiap ballot menjadi satu mata rantai hash per election.
+// Weight adalah bobot suara pemilih DPT yang disalin saat suara diberikan.
+// Pada election dengan pemungutan ulang RevoteTag adalah hash token yang hanya dipegang pemilih
+// dan Supersedes berisi sequence ballot yang digantikan
+type Ballot struct {
+	ID          string  `db:"id" json:"id"`
+	ElectionID  int     `db:"election_id" json:"election_id"`
+	Sequence    int     `db:"sequence" json:"sequence"`
+	ReceiptCode string  `db:"receipt_code" json:"receipt_code"`
+	Content     string  `db:"content" json:"content"`
+	PrevHash    string  `db:"prev_hash" json:"prev_hash"`
+	Hash        string  `db:"hash" json:"hash"`
+	Weight      int     `db:"weight" json:"weight"`
+	RevoteTag   *string `db:"revote_tag" json:"-"`
+	Supersedes  *int    `db:"supersedes" json:"supersedes"`
+}
+
+func (Ballot) TableName() string {
+	return "ballots"
+}
+
+// ComputeHash - SHA-256 dari hash sebelumnya dan isi ballot
+// Format harus sama dengan backfill pada migration 12_ballot_hash_chain.
+// Bobot selain 1 dan ballot yang digantikan ikut di-hash, ballot tanpa keduanya memakai format lama
+// sehingga rantai lama tetap valid
+func (b Ballot) ComputeHash() string {
+	payload := fmt.Sprintf("%s|%d|%d|%s|%s", b.PrevHash, b.ElectionID, b.Sequence, b.ReceiptCode, b.Content)
+	if b.Weight > 1 {
+		payload += fmt.Sprintf("|w=%d", b.Weight)
+	}
+	if b.Supersedes != nil {
+		payload += fmt.Sprintf("|s=%d", *b.Supersedes)
+	}
+	sum := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(sum[:])
+}
+
+// RevoteTag - Hash token pemungutan ulang milik pemilih, terikat ke election dan entri DPT.
+// Token acak hanya dipegang pemilih sehingga tanpa token itu server pun tidak bisa menghitung tag
+// milik seorang pemilih, dan token pemilih lain tidak cocok dengan entri DPT yang berbeda
+func RevoteTag(electionID int, voterRollID int, token string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%d|%s", electionID, voterRollID, token)))
+	return hex.EncodeToString(sum[:])
+}
+
+// BallotContent - Pilihan yang disimpan (dalam bentuk JSON) pada kolom content.
+// Satu ballot mencakup seluruh contest election.
+// Choices dan CandidateID hanya ada pada ballot lama sebelum ada contest,
+// tidak boleh dimigrasi karena content ikut di-hash.
+// Encrypted berisi BallotContent terenkripsi (base64) untuk election dengan trustee
+type BallotContent struct {
+	Contests    []ContestChoice `json:"contests,omitempty"`
+	Choices     []int           `json:"choices,omitempty"`
+	CandidateID int             `json:"candidate_id,omitempty"`
+	Encrypted   string          `json:"encrypted,omitempty"`
+}
+
+// ContestChoice - Pilihan pada satu contest. Choices berurutan sesuai peringkat untuk irv/borda,
+// terurut menaik untuk plurality/approval. Choices kosong berarti suara kosong,
+// kecuali Abstain (pemilih sengaja abstain)
+type ContestChoice struct {
+	ContestID int   `json:"contest_id"`
+	Choices   []int `json:"choices"`
+	Abstain   bool  `json:"abstain,omitempty"`
+}
+
+// Selection - Pilihan ballot pada sebuah contest, found false jika contest tidak ada pada ballot.
+// Ballot format lama hanya berisi contest default (contest pertama) dari election,
+// content kosong (mis. gagal dibaca) dianggap tidak berisi contest apa pun
+func (c BallotContent) Selection(contestID int, defaultContestID int) (choice ContestChoice, found bool) {
+	if len(c.Contests) == 0 {
+		if contestID != defaultContestID {
+			return
+		}
+		choice = ContestChoice{ContestID: contestID, Choices: c.Choices}
+		if len(c.Choices) == 0 && c.CandidateID != 0 {
+			choice.Choices = []int{c.CandidateID}
+		}
+		return choice, len(choice.Choices) > 0
+	}
+
+	for _, contest := range c.Contests {
+		if contest.ContestID == contestID {
+			return contest, true
+		}
+	}
+	return
+}
+
+// ChainHead - Posisi terakhir rantai hash sebuah election
+type ChainHead struct {
+	ElectionID int    `db:"election_id" json:"election_id"`
+	Sequence   int    `db:"sequence" json:"sequence"`
+	Hash       string `db:"hash" json:"hash"`
+}
+
+func (ChainHead) TableName() string {
+	return "ballot_chain_heads"
+}
+
+// Participation - Buku partisipasi, hanya mencatat bahwa entri DPT sudah memilih
+type Participation struct {
+	ElectionID  int `db:"election_id" json:"election_id"`
+	VoterRollID int `db:"voter_roll_id" json:"voter_roll_id"`
+}
+
+func (Participation) TableName() string {
+	return "election_participations"
+}
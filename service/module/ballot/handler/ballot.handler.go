@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+)
+
+// CastBallot - Berikan suara pada election
+// @POST /elections/:id/ballots
+// Body: {contests: [{contest_id: int, choices: []int, abstain?: bool}], delegation_id?: int, revote_token?: string} (urutan choices = peringkat untuk irv/borda, choices kosong = suara kosong)
+// @return BallotReceiptResponse (receipt_code disimpan oleh pemilih)
+func (h *BallotHandler) CastBallot(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CastBallotRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.BallotUsecase.CastBallot(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Ballot cast successfully", res)
+}
+
+// CastBallotWithCode - Berikan suara memakai ballot token dari penukaran kode voting (pemilih tanpa akun)
+// @POST /elections/:id/ballots/code
+// Header: Authorization: Bearer <ballot_token>
+// Body: sama dengan CastBallot
+func (h *BallotHandler) CastBallotWithCode(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CastBallotRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.BallotUsecase.CastBallotWithCode(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Ballot cast successfully", res)
+}
+
+// CastBallotWithKiosk - Berikan suara dari kiosk TPS, memakai surat suara yang dibuka petugas TPS
+// @POST /elections/:id/ballots/kiosk
+// Header: Authorization: Bearer <kiosk_token>
+// Body: sama dengan CastBallot
+func (h *BallotHandler) CastBallotWithKiosk(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CastBallotRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.BallotUsecase.CastBallotWithKiosk(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Ballot cast successfully", res)
+}
+
+// GetBallotPaper - Surat suara pemilih, urutan candidate sesuai pengaturan election (acak per pemilih untuk random)
+// @GET /elections/:id/ballots/paper
+func (h *BallotHandler) GetBallotPaper(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.GetBallotPaper(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot paper retrieved successfully", res)
+}
+
+// GetBallotPaperWithCode - Surat suara untuk pemilih tanpa akun
+// @GET /elections/:id/ballots/code/paper
+// Header: Authorization: Bearer <ballot_token>
+func (h *BallotHandler) GetBallotPaperWithCode(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.GetBallotPaperWithCode(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot paper retrieved successfully", res)
+}
+
+// GetBallotPaperWithKiosk - Surat suara di kiosk TPS untuk pemilih yang sedang dilayani
+// @GET /elections/:id/ballots/kiosk/paper
+// Header: Authorization: Bearer <kiosk_token>
+func (h *BallotHandler) GetBallotPaperWithKiosk(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.GetBallotPaperWithKiosk(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot paper retrieved successfully", res)
+}
+
+// GetResults - Hasil penghitungan suara per contest, termasuk rincian per putaran untuk irv
+// @GET /elections/:id/results
+func (h *BallotHandler) GetResults(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.GetResults(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election results retrieved successfully", res)
+}
+
+// GetTurnout - Ringkasan partisipasi pemilih
+// @GET /elections/:id/ballots/turnout
+func (h *BallotHandler) GetTurnout(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.GetTurnout(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Turnout retrieved successfully", res)
+}
+
+// GetVoterParticipations - Daftar pemilih beserta status sudah/belum memilih
+// @GET /elections/:id/ballots/participations
+func (h *BallotHandler) GetVoterParticipations(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	res, totalRecords, sysErr := h.BallotUsecase.GetVoterParticipations(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendPaginatedResponse(ctx, fiber.StatusOK, "Voter participations retrieved successfully",
+		pagination.Page, pagination.PageSize, totalRecords, res)
+}
+
+// VerifyReceipt - Cek apakah kode bukti suara tercatat di rantai ballot (public)
+// @GET /elections/:id/ballots/receipts/:receipt_code
+func (h *BallotHandler) VerifyReceipt(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.VerifyReceipt(ctx, electionID, ctx.Params("receipt_code"))
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Receipt verified successfully", res)
+}
+
+// AuditChain - Telusuri ulang rantai hash ballot (public)
+// @GET /elections/:id/ballots/audit
+func (h *BallotHandler) AuditChain(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.AuditChain(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot chain audited successfully", res)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/ballot/usecase"
+
+type BallotHandler struct {
+	BallotUsecase usecase.IBallotUsecase
+}
+
+func InitBallotHandler(ballotUsecase usecase.IBallotUsecase) *BallotHandler {
+	return &BallotHandler{
+		BallotUsecase: ballotUsecase,
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// liveThrottle - jeda minimal antar update, banyak suara dalam satu jeda digabung jadi satu update
+	liveThrottle = time.Second
+	// liveHeartbeat - komentar SSE berkala supaya koneksi tidak diputus proxy dan klien yang hilang terdeteksi
+	liveHeartbeat = 15 * time.Second
+)
+
+// IssueStreamToken - Stream token berumur pendek untuk membuka stream live dari EventSource
+// @POST /elections/:id/live/token
+func (h *BallotHandler) IssueStreamToken(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.BallotUsecase.IssueStreamToken(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Stream token issued successfully", res)
+}
+
+// StreamLive - Stream Server-Sent Events turnout (dan hasil setelah ditutup) sebuah election
+// @GET /elections/:id/live
+// Token lewat header Authorization, atau ?stream_token= dari IssueStreamToken untuk EventSource
+// Event: "update" berisi LiveUpdate, "error" jika update gagal diambil
+func (h *BallotHandler) StreamLive(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	pubsub, withResults, sysErr := h.BallotUsecase.SubscribeLive(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	// ctx request sudah dikembalikan ke pool saat stream berjalan, query memakai ctx tersendiri
+	app := ctx.App()
+	return ctx.SendStreamWriter(func(w *bufio.Writer) {
+		defer pubsub.Close()
+
+		streamCtx := app.AcquireCtx(&fasthttp.RequestCtx{})
+		defer app.ReleaseCtx(streamCtx)
+
+		send := func() error {
+			update, sysErr := h.BallotUsecase.GetLiveUpdate(streamCtx, electionID, withResults)
+			if sysErr != nil {
+				writeEvent(w, "error", fiber.Map{"message": sysErr.GetMessage()})
+			} else {
+				writeEvent(w, "update", update)
+			}
+			return w.Flush()
+		}
+
+		if send() != nil {
+			return
+		}
+
+		messages := pubsub.Channel()
+		throttle := time.NewTicker(liveThrottle)
+		defer throttle.Stop()
+		heartbeat := time.NewTicker(liveHeartbeat)
+		defer heartbeat.Stop()
+
+		pending := false
+		for {
+			select {
+			case _, ok := <-messages:
+				if !ok {
+					return
+				}
+				pending = true
+			case <-throttle.C:
+				if !pending {
+					continue
+				}
+				pending = false
+				if send() != nil {
+					return
+				}
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+				if w.Flush() != nil {
+					return
+				}
+			}
+		}
+	})
+}
+
+func writeEvent(w *bufio.Writer, event string, data interface{}) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+}
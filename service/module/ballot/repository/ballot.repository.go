@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/entity"
+)
+
+const ballotColumns = `id, election_id, sequence, receipt_code, content, prev_hash, hash, weight, revote_tag, supersedes`
+
+// CreateBallot - Simpan isi suara tanpa identitas pemilih sebagai mata rantai berikutnya
+func (r *BallotRepository) CreateBallot(ctx fiber.Ctx, ballot entity.Ballot) (res entity.Ballot, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.ballots (election_id, sequence, receipt_code, content, prev_hash, hash, weight, revote_tag, supersedes)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	          RETURNING ` + ballotColumns
+
+	model := db.Get(&res, query, ballot.ElectionID, ballot.Sequence, ballot.ReceiptCode, ballot.Content, ballot.PrevHash, ballot.Hash,
+		ballot.Weight, ballot.RevoteTag, ballot.Supersedes)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan suara")
+		return
+	}
+	return
+}
+
+// GetBallotByReceiptCode - Cari ballot berdasarkan kode bukti milik pemilih
+func (r *BallotRepository) GetBallotByReceiptCode(ctx fiber.Ctx, electionID int, receiptCode string) (res entity.Ballot, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + ballotColumns + ` FROM public.ballots WHERE election_id = $1 AND receipt_code = $2`
+
+	model := db.Get(&res, query, electionID, receiptCode)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Kode bukti suara tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil ballot")
+	}
+	return
+}
+
+// GetRevotableBallot - Ballot dengan revote tag tertentu yang belum digantikan ballot lain
+func (r *BallotRepository) GetRevotableBallot(ctx fiber.Ctx, electionID int, revoteTag string) (res entity.Ballot, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + ballotColumns + ` FROM public.ballots b
+	          WHERE b.election_id = $1 AND b.revote_tag = $2
+	            AND NOT EXISTS (SELECT 1 FROM public.ballots s WHERE s.election_id = b.election_id AND s.supersedes = b.sequence)`
+
+	model := db.Get(&res, query, electionID, revoteTag)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Ballot pemilih tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil ballot")
+	}
+	return
+}
+
+// GetBallotsByElectionID - Seluruh ballot sebuah election sesuai urutan rantai
+func (r *BallotRepository) GetBallotsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Ballot, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + ballotColumns + ` FROM public.ballots WHERE election_id = $1 ORDER BY sequence`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil ballots")
+		return
+	}
+	return
+}
+
+// LockChainHead - Ambil (atau buat) kepala rantai sekaligus menguncinya sampai transaction selesai,
+// sehingga append ballot pada election yang sama berjalan berurutan
+func (r *BallotRepository) LockChainHead(ctx fiber.Ctx, electionID int) (res entity.ChainHead, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.ballot_chain_heads (election_id, sequence, hash)
+	          VALUES ($1, 0, $2)
+	          ON CONFLICT (election_id) DO UPDATE SET election_id = EXCLUDED.election_id
+	          RETURNING election_id, sequence, hash`
+
+	model := db.Get(&res, query, electionID, entity.GenesisHash)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengunci rantai ballot")
+		return
+	}
+	return
+}
+
+// GetChainHead - Kepala rantai terakhir, genesis jika belum ada ballot
+func (r *BallotRepository) GetChainHead(ctx fiber.Ctx, electionID int) (res entity.ChainHead, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT election_id, sequence, hash FROM public.ballot_chain_heads WHERE election_id = $1`
+
+	model := db.Get(&res, query, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		res = entity.ChainHead{ElectionID: electionID, Hash: entity.GenesisHash}
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kepala rantai ballot")
+	}
+	return
+}
+
+func (r *BallotRepository) UpdateChainHead(ctx fiber.Ctx, head entity.ChainHead) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.ballot_chain_heads SET sequence = $1, hash = $2 WHERE election_id = $3`
+
+	_, err := db.Exec(query, head.Sequence, head.Hash, head.ElectionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengubah kepala rantai ballot")
+		return
+	}
+	return
+}
+
+// CreateParticipation - Catat bahwa pemilih sudah memilih. Primary key (election_id, voter_roll_id)
+// menjadi penjaga terakhir double voting walaupun ada request bersamaan dari beberapa device
+func (r *BallotRepository) CreateParticipation(ctx fiber.Ctx, participation entity.Participation) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_participations (election_id, voter_roll_id) VALUES ($1, $2)`
+
+	_, err := db.Exec(query, participation.ElectionID, participation.VoterRollID)
+	if database.IsUniqueViolation(err) {
+		sysError = syserror.CreateError(err, fiber.StatusConflict, "Anda sudah memberikan suara pada election ini")
+		return
+	} else if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mencatat partisipasi")
+	}
+	return
+}
+
+// GetTurnout - Jumlah pemilih dan bobot suara DPT dibanding yang sudah memilih
+func (r *BallotRepository) GetTurnout(ctx fiber.Ctx, electionID int) (res dto.TurnoutResponse, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	res.ElectionID = electionID
+
+	query := `SELECT COUNT(*) AS total_eligible, COALESCE(SUM(weight), 0) AS total_eligible_weight
+	          FROM public.voter_rolls WHERE election_id = $1`
+
+	model := db.Get(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil jumlah pemilih")
+		return
+	}
+
+	// bobot diambil dari DPT saat ini, DPT dibekukan selama election dibuka sehingga sama dengan bobot pada ballot
+	query = `SELECT COUNT(*) AS total_voted, COALESCE(SUM(v.weight), 0) AS total_voted_weight
+	         FROM public.election_participations p
+	         JOIN public.voter_rolls v ON v.id = p.voter_roll_id
+	         WHERE p.election_id = $1`
+
+	model = db.Get(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil jumlah partisipasi")
+		return
+	}
+
+	if res.TotalEligible > 0 {
+		res.TurnoutPercentage = float64(res.TotalVoted) / float64(res.TotalEligible) * 100
+	}
+	if res.TotalEligibleWeight > 0 {
+		res.WeightPercentage = float64(res.TotalVotedWeight) / float64(res.TotalEligibleWeight) * 100
+	}
+	return
+}
+
+// GetVoterParticipations - Daftar pemilih DPT beserta status sudah/belum memilih
+func (r *BallotRepository) GetVoterParticipations(ctx fiber.Ctx, electionID int) (res []dto.VoterParticipation, totalRecords int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	// Parse pagination
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	offset := helper.GetOffset(pagination.Page, pagination.PageSize)
+
+	model := db.Get(&totalRecords, `SELECT COUNT(*) FROM public.voter_rolls WHERE election_id = $1`, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil total records")
+		return
+	}
+
+	query := `SELECT v.id AS voter_roll_id, v.user_id, v.name, v.email, v.member_number, v.weight, (p.voter_roll_id IS NOT NULL) AS has_voted
+	          FROM public.voter_rolls v
+	          LEFT JOIN public.election_participations p ON p.voter_roll_id = v.id AND p.election_id = v.election_id
+	          WHERE v.election_id = $1
+	          ORDER BY v.name, v.id
+	          LIMIT $2 OFFSET $3`
+
+	model = db.Select(&res, query, electionID, pagination.PageSize, offset)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil partisipasi pemilih")
+		return
+	}
+	return
+}
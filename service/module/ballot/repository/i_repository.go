@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/entity"
+)
+
+type BallotRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitBallotRepository(mainDB *database.MainDB) IBallotRepository {
+	return &BallotRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *BallotRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IBallotRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	CreateBallot(ctx fiber.Ctx, ballot entity.Ballot) (res entity.Ballot, sysError syserror.SysError)
+	GetBallotByReceiptCode(ctx fiber.Ctx, electionID int, receiptCode string) (res entity.Ballot, sysError syserror.SysError)
+	GetRevotableBallot(ctx fiber.Ctx, electionID int, revoteTag string) (res entity.Ballot, sysError syserror.SysError)
+	GetBallotsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Ballot, sysError syserror.SysError)
+	LockChainHead(ctx fiber.Ctx, electionID int) (res entity.ChainHead, sysError syserror.SysError)
+	GetChainHead(ctx fiber.Ctx, electionID int) (res entity.ChainHead, sysError syserror.SysError)
+	UpdateChainHead(ctx fiber.Ctx, head entity.ChainHead) (sysError syserror.SysError)
+	CreateParticipation(ctx fiber.Ctx, participation entity.Participation) (sysError syserror.SysError)
+	GetTurnout(ctx fiber.Ctx, electionID int) (res dto.TurnoutResponse, sysError syserror.SysError)
+	GetVoterParticipations(ctx fiber.Ctx, electionID int) (res []dto.VoterParticipation, totalRecords int64, sysError syserror.SysError)
+}
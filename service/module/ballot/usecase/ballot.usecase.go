@@ -0,0 +1,842 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/entity"
+	candidateEntity "github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	voterRollEntity "github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+// receiptCodeLength - panjang kode bukti suara yang diberikan ke pemilih
+const receiptCodeLength = 16
+
+// revoteTokenLength - panjang token pemungutan ulang, cukup panjang supaya revote tag tidak bisa ditebak
+const revoteTokenLength = 32
+
+// CastBallot - Simpan suara user yang login untuk election tertentu
+// Seluruh pengecekan dan insert berjalan dalam satu transaction, double voting
+// dicegah oleh primary key buku partisipasi di database (bukan hanya pre-check).
+// Hak pilih ditentukan oleh DPT election, bukan hanya keanggotaan organization.
+// Dengan delegation_id suara dicatat atas nama delegator, user yang login harus proxy delegasi tersebut.
+// Partisipasi dan isi suara ditulis ke tabel terpisah yang tidak saling mereferensi
+func (u *BallotUsecase) CastBallot(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	// didaftarkan sebelum transaction sehingga dijalankan setelah commit
+	defer u.publishBallotCast(electionID, &sysError)
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		// commit yang gagal harus sampai ke pemilih, bukan tanda terima untuk suara yang tidak tersimpan
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.getOpenElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	voter, sysError := u.voterRollUse.GetVoterRollByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Anda tidak terdaftar dalam DPT election ini")
+		}
+		return
+	}
+
+	if req.DelegationID != nil {
+		delegatorID, errUse := u.delegationUse.UseDelegation(ctx, electionID, voter.ID, *req.DelegationID)
+		if errUse != nil {
+			sysError = errUse
+			return
+		}
+		if voter, sysError = u.voterRollUse.GetVoterRollByID(ctx, electionID, delegatorID); sysError != nil {
+			return
+		}
+	}
+
+	res, sysError = u.castBallot(ctx, election, voter, req)
+	return
+}
+
+// CastBallotWithCode - Simpan suara pemilih tanpa akun memakai ballot token hasil penukaran kode voting.
+// Kode voting ditandai terpakai dalam transaction yang sama dengan suara
+func (u *BallotUsecase) CastBallotWithCode(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError) {
+	claims, err := middleware.GetBallotClaims(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "Ballot token tidak valid")
+		return
+	}
+	if claims.ElectionID != electionID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Ballot token bukan untuk election ini")
+		return
+	}
+	if req.DelegationID != nil {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Suara atas nama delegator hanya dapat diberikan oleh proxy yang login")
+		return
+	}
+
+	defer u.publishBallotCast(electionID, &sysError)
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.getOpenElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	voter, sysError := u.voterRollUse.GetVoterRollByID(ctx, electionID, claims.VoterRollID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Anda tidak terdaftar dalam DPT election ini")
+		}
+		return
+	}
+
+	if sysError = u.votingCodeUse.RedeemVotingCode(ctx, electionID, voter.ID, claims.CodeHash); sysError != nil {
+		return
+	}
+
+	res, sysError = u.castBallot(ctx, election, voter, req)
+	return
+}
+
+// CastBallotWithKiosk - Simpan suara dari kiosk TPS untuk pemilih yang surat suaranya dibuka petugas.
+// Surat suara terbuka dipakai di transaction yang sama sehingga satu pembukaan hanya menghasilkan satu suara
+func (u *BallotUsecase) CastBallotWithKiosk(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError) {
+	if req.DelegationID != nil {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Suara atas nama delegator hanya dapat diberikan oleh proxy yang login")
+		return
+	}
+
+	defer u.publishBallotCast(electionID, &sysError)
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.getOpenElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	voterRollID, sysError := u.kioskUse.RedeemUnlock(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	voter, sysError := u.voterRollUse.GetVoterRollByID(ctx, electionID, voterRollID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Pemilih tidak terdaftar dalam DPT election ini")
+		}
+		return
+	}
+
+	res, sysError = u.castBallot(ctx, election, voter, req)
+	return
+}
+
+// publishBallotCast - Beri tahu stream live bahwa ada suara baru, hanya jika suara berhasil disimpan
+func (u *BallotUsecase) publishBallotCast(electionID int, sysError *syserror.SysError) {
+	if *sysError == nil {
+		u.redisDb.PublishElectionEvent(redisdb.ElectionEvent{Type: redisdb.EventBallotCast, ElectionID: electionID})
+	}
+}
+
+// getOpenElection - Ambil election dengan share lock supaya tidak ditutup selama suara disimpan,
+// pastikan election sedang dibuka. Harus dipanggil di dalam transaction
+func (u *BallotUsecase) getOpenElection(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetElectionByIDForShare(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election tidak sedang dibuka untuk pemungutan suara")
+	}
+	return
+}
+
+// castBallot - Catat partisipasi entri DPT lalu tambahkan isi suara beserta bobot pemilih ke rantai ballot.
+// Harus dipanggil di dalam transaction setelah hak pilih pemilih dicek.
+// Pemilih yang suaranya masih dikuasakan ditolak, delegasi yang sudah dipakai proxy tidak lagi aktif.
+// Pada election dengan pemungutan ulang, pemilih yang sudah memilih menambahkan ballot baru yang menggantikan ballot terakhirnya
+func (u *BallotUsecase) castBallot(ctx fiber.Ctx, election electionEntity.Election, voter voterRollEntity.VoterRoll, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError) {
+	electionID := election.ID
+	if sysError = u.delegationUse.EnsureNotDelegated(ctx, electionID, voter.ID); sysError != nil {
+		return
+	}
+
+	contestChoices, sysError := u.validateChoices(ctx, electionID, req.Contests)
+	if sysError != nil {
+		return
+	}
+
+	receiptCode, err := helper.RandomCode(receiptCodeLength)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat kode bukti suara")
+		return
+	}
+
+	ballot := entity.Ballot{
+		ElectionID:  electionID,
+		ReceiptCode: receiptCode,
+		Weight:      max(voter.Weight, 1),
+	}
+	var revoteToken string
+	if election.AllowRevote {
+		if revoteToken, sysError = u.findSupersededBallot(ctx, voter, req.RevoteToken, &ballot); sysError != nil {
+			return
+		}
+	}
+
+	// pemungutan ulang tidak menambah partisipasi, pemilih sudah tercatat saat ballot pertamanya
+	if ballot.Supersedes == nil {
+		sysError = u.ballotRepo.CreateParticipation(ctx, entity.Participation{
+			ElectionID:  electionID,
+			VoterRollID: voter.ID,
+		})
+		if sysError != nil {
+			if election.AllowRevote && sysError.GetStatusCode() == fiber.StatusConflict {
+				sysError = syserror.CreateError(sysError.GetError(), fiber.StatusConflict, "Anda sudah memberikan suara, sertakan revote_token dari bukti suara terakhir untuk memilih ulang")
+			}
+			return
+		}
+	}
+
+	content, err := json.Marshal(entity.BallotContent{Contests: contestChoices})
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyusun isi suara")
+		return
+	}
+
+	// election dengan trustee hanya menyimpan ciphertext, isi suara baru terbaca setelah dekripsi
+	ciphertext, encrypted, sysError := u.trusteeUse.EncryptBallot(ctx, electionID, content)
+	if sysError != nil {
+		return
+	}
+	if encrypted {
+		content, err = json.Marshal(entity.BallotContent{Encrypted: ciphertext})
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyusun isi suara")
+			return
+		}
+	}
+
+	ballot.Content = string(content)
+	ballot, sysError = u.appendBallot(ctx, ballot)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.BallotReceiptResponse{
+		ElectionID:  ballot.ElectionID,
+		ReceiptCode: ballot.ReceiptCode,
+		Sequence:    ballot.Sequence,
+		Hash:        ballot.Hash,
+		CastAt:      helper.Now(),
+		RevoteToken: revoteToken,
+	}
+	return
+}
+
+// validateChoices - Cek pilihan setiap contest terhadap candidate, jumlah kursi dan metode penghitungannya.
+// Setiap contest election wajib ada tepat satu kali, hasilnya disusun sesuai urutan contest.
+// Untuk metode tanpa peringkat pilihan diurutkan supaya isi ballot tidak bergantung urutan input
+func (u *BallotUsecase) validateChoices(ctx fiber.Ctx, electionID int, req []dto.ContestChoiceRequest) (res []entity.ContestChoice, sysError syserror.SysError) {
+	contests, sysError := u.contestUse.GetContests(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	candidates, sysError := u.candidateUse.GetCandidates(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	candidateIDs := contestCandidateIDs(candidates)
+
+	submitted := make(map[int]dto.ContestChoiceRequest, len(req))
+	for _, choice := range req {
+		if _, exists := submitted[choice.ContestID]; exists {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, fmt.Sprintf("Contest %d diisi lebih dari sekali", choice.ContestID))
+			return
+		}
+		submitted[choice.ContestID] = choice
+	}
+	if len(submitted) != len(contests) {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Ballot harus berisi pilihan untuk setiap contest election")
+		return
+	}
+
+	for _, contest := range contests {
+		choices, ok := submitted[contest.ID]
+		if !ok {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Pilihan untuk contest "+contest.Name+" belum diisi")
+			return
+		}
+
+		method := tally.Method(contest.VotingMethod)
+		ballot := tally.Ballot{Choices: choices.Choices, Abstain: choices.Abstain, Blank: !choices.Abstain && len(choices.Choices) == 0}
+		if err := tally.Validate(method, candidateIDs[contest.ID], ballot, contest.Seats); err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Pilihan untuk contest "+contest.Name+" tidak valid")
+			return
+		}
+
+		// suara kosong dan abstain disimpan dengan choices [] supaya bentuk content tetap sama
+		selected := append([]int{}, choices.Choices...)
+		if method == tally.MethodPlurality || method == tally.MethodApproval {
+			slices.Sort(selected)
+		}
+		res = append(res, entity.ContestChoice{ContestID: contest.ID, Choices: selected, Abstain: choices.Abstain})
+	}
+	return
+}
+
+// contestCandidateIDs - Kelompokkan id candidate per contest
+func contestCandidateIDs(candidates []candidateEntity.Candidate) map[int][]int {
+	res := make(map[int][]int)
+	for _, candidate := range candidates {
+		res[candidate.ContestID] = append(res[candidate.ContestID], candidate.ID)
+	}
+	return res
+}
+
+// findSupersededBallot - Beri ballot baru revote tag dari token pemungutan ulang yang baru dan, jika pemilih
+// menyertakan token dari bukti suara terakhirnya, tandai ballot tersebut sebagai yang digantikan.
+// Kepala rantai dikunci lebih dulu supaya dua pemungutan ulang bersamaan tidak menggantikan ballot yang sama
+func (u *BallotUsecase) findSupersededBallot(ctx fiber.Ctx, voter voterRollEntity.VoterRoll, revoteToken string, ballot *entity.Ballot) (newToken string, sysError syserror.SysError) {
+	if _, sysError = u.ballotRepo.LockChainHead(ctx, ballot.ElectionID); sysError != nil {
+		return
+	}
+
+	if revoteToken != "" {
+		previous, errPrevious := u.ballotRepo.GetRevotableBallot(ctx, ballot.ElectionID, entity.RevoteTag(ballot.ElectionID, voter.ID, revoteToken))
+		if errPrevious != nil {
+			sysError = errPrevious
+			if errPrevious.GetStatusCode() == fiber.StatusNotFound {
+				sysError = syserror.CreateError(errPrevious.GetError(), fiber.StatusConflict, "Token pemungutan ulang tidak valid atau ballot-nya sudah digantikan")
+			}
+			return
+		}
+		ballot.Supersedes = &previous.Sequence
+	}
+
+	newToken, err := helper.RandomCode(revoteTokenLength)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat token pemungutan ulang")
+		return
+	}
+	revoteTag := entity.RevoteTag(ballot.ElectionID, voter.ID, newToken)
+	ballot.RevoteTag = &revoteTag
+	return
+}
+
+// appendBallot - Tambahkan ballot sebagai mata rantai berikutnya. Harus dipanggil di dalam transaction,
+// kepala rantai dikunci sehingga dua ballot tidak bisa mendapat sequence yang sama
+func (u *BallotUsecase) appendBallot(ctx fiber.Ctx, ballot entity.Ballot) (res entity.Ballot, sysError syserror.SysError) {
+	head, sysError := u.ballotRepo.LockChainHead(ctx, ballot.ElectionID)
+	if sysError != nil {
+		return
+	}
+
+	ballot.Sequence = head.Sequence + 1
+	ballot.PrevHash = head.Hash
+	ballot.Hash = ballot.ComputeHash()
+
+	res, sysError = u.ballotRepo.CreateBallot(ctx, ballot)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.ballotRepo.UpdateChainHead(ctx, entity.ChainHead{
+		ElectionID: ballot.ElectionID,
+		Sequence:   res.Sequence,
+		Hash:       res.Hash,
+	})
+	return
+}
+
+// VerifyReceipt - Cek apakah ballot dengan kode bukti tertentu ada di rantai dan hash-nya utuh
+func (u *BallotUsecase) VerifyReceipt(ctx fiber.Ctx, electionID int, receiptCode string) (res dto.ReceiptVerificationResponse, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	ballot, sysError := u.ballotRepo.GetBallotByReceiptCode(ctx, electionID, strings.ToUpper(strings.TrimSpace(receiptCode)))
+	if sysError != nil {
+		return
+	}
+
+	head, sysError := u.ballotRepo.GetChainHead(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.ReceiptVerificationResponse{
+		ElectionID:    electionID,
+		ReceiptCode:   ballot.ReceiptCode,
+		Included:      true,
+		Sequence:      ballot.Sequence,
+		PrevHash:      ballot.PrevHash,
+		Hash:          ballot.Hash,
+		HashValid:     ballot.ComputeHash() == ballot.Hash,
+		ChainLength:   head.Sequence,
+		ChainHeadHash: head.Hash,
+	}
+	return
+}
+
+// AuditChain - Telusuri ulang seluruh rantai ballot dan laporkan mata rantai pertama yang rusak
+func (u *BallotUsecase) AuditChain(ctx fiber.Ctx, electionID int) (res dto.ChainAuditResponse, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	ballots, sysError := u.ballotRepo.GetBallotsByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	head, sysError := u.ballotRepo.GetChainHead(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = auditChain(electionID, ballots, head)
+	return
+}
+
+// auditChain - Setiap ballot harus berurutan, menunjuk hash ballot sebelumnya,
+// dan hash-nya harus sama dengan hasil hitung ulang. Ballot terakhir harus sama dengan kepala rantai.
+// Ballot pemungutan ulang hanya boleh menggantikan ballot sebelumnya yang belum digantikan
+func auditChain(electionID int, ballots []entity.Ballot, head entity.ChainHead) dto.ChainAuditResponse {
+	res := dto.ChainAuditResponse{
+		ElectionID:   electionID,
+		Valid:        true,
+		TotalBallots: len(ballots),
+		HeadSequence: head.Sequence,
+		HeadHash:     head.Hash,
+	}
+
+	broken := func(sequence int, reason string) dto.ChainAuditResponse {
+		res.Valid = false
+		res.BrokenAtSequence = &sequence
+		res.Reason = reason
+		return res
+	}
+
+	prevHash := entity.GenesisHash
+	superseded := make(map[int]bool)
+	for i, ballot := range ballots {
+		expectedSequence := i + 1
+		if ballot.Sequence != expectedSequence {
+			return broken(expectedSequence, fmt.Sprintf("sequence %d hilang atau tertukar (ditemukan %d)", expectedSequence, ballot.Sequence))
+		}
+		if ballot.PrevHash != prevHash {
+			return broken(ballot.Sequence, "prev_hash tidak sama dengan hash ballot sebelumnya")
+		}
+		if ballot.ComputeHash() != ballot.Hash {
+			return broken(ballot.Sequence, "hash tidak sesuai dengan isi ballot")
+		}
+		if ballot.Supersedes != nil {
+			if *ballot.Supersedes < 1 || *ballot.Supersedes >= ballot.Sequence || superseded[*ballot.Supersedes] {
+				return broken(ballot.Sequence, "ballot menggantikan ballot yang tidak ada atau sudah digantikan")
+			}
+			superseded[*ballot.Supersedes] = true
+			res.SupersededBallots++
+		}
+		prevHash = ballot.Hash
+	}
+
+	if head.Sequence != len(ballots) || head.Hash != prevHash {
+		return broken(len(ballots)+1, "kepala rantai tidak sesuai dengan ballot terakhir")
+	}
+	return res
+}
+
+// GetResults - Hitung hasil setiap contest dari isi ballot pada rantai sesuai metode dan kursi contest.
+// Setelah closed hanya admin organization yang dapat melihat, setelah published terbuka untuk semua user
+func (u *BallotUsecase) GetResults(ctx fiber.Ctx, electionID int) (res dto.ElectionResultResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	switch election.Status {
+	case electionEntity.StatusPublished:
+	case electionEntity.StatusClosed:
+		if election, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+			return
+		}
+	default:
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Hasil baru tersedia setelah election ditutup")
+		return
+	}
+
+	res, sysError = u.countResults(ctx, election)
+	return
+}
+
+// countResults - Hitung hasil seluruh contest election tanpa cek hak akses, pemanggil wajib mengeceknya
+func (u *BallotUsecase) countResults(ctx fiber.Ctx, election electionEntity.Election) (res dto.ElectionResultResponse, sysError syserror.SysError) {
+	electionID := election.ID
+	contests, sysError := u.contestUse.GetContests(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	candidates, sysError := u.candidateUse.GetCandidates(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	ballots, sysError := u.ballotRepo.GetBallotsByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	ballots, superseded := countedBallots(ballots)
+
+	// content yang tidak bisa dibaca tetap dihitung sebagai ballot tidak valid
+	contents := make([]entity.BallotContent, len(ballots))
+	for i, ballot := range ballots {
+		_ = json.Unmarshal([]byte(ballot.Content), &contents[i])
+	}
+	if sysError = u.decryptContents(ctx, electionID, contents); sysError != nil {
+		return
+	}
+
+	// quorum pertanyaan referendum dihitung terhadap bobot suara DPT
+	turnout, sysError := u.ballotRepo.GetTurnout(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	turnout.Contests = make([]dto.ContestTurnout, 0, len(contests))
+
+	// seed undian seri, rantai sudah tidak berubah setelah election ditutup
+	head, sysError := u.ballotRepo.GetChainHead(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	candidateIDs := contestCandidateIDs(candidates)
+	res = dto.ElectionResultResponse{
+		ElectionID:        election.ID,
+		Status:            election.Status,
+		TotalBallots:      len(ballots),
+		SupersededBallots: superseded,
+		Contests:          make([]dto.ContestResult, 0, len(contests)),
+	}
+
+	for _, contest := range contests {
+		contestCandidates := make([]candidateEntity.Candidate, 0, len(candidateIDs[contest.ID]))
+		for _, candidate := range candidates {
+			if candidate.ContestID == contest.ID {
+				contestCandidates = append(contestCandidates, candidate)
+			}
+		}
+
+		// contest pertama adalah contest default untuk ballot format lama,
+		// contest yang tidak ada pada ballot dihitung tidak valid, bukan suara kosong
+		tallyBallots := make([]tally.Ballot, 0, len(contents))
+		for i, content := range contents {
+			choice, found := content.Selection(contest.ID, contests[0].ID)
+			tallyBallots = append(tallyBallots, tally.Ballot{
+				Choices: choice.Choices,
+				Weight:  int64(ballots[i].Weight),
+				Abstain: choice.Abstain,
+				Blank:   found && !choice.Abstain && len(choice.Choices) == 0,
+			})
+		}
+
+		result, err := tally.Count(tally.Method(contest.VotingMethod), candidateIDs[contest.ID], tallyBallots, contest.Seats)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghitung hasil contest "+contest.Name)
+			return
+		}
+
+		contestResult := dto.ContestResult{
+			Contest:    contest,
+			Candidates: contestCandidates,
+		}
+		contestResult.Result, contestResult.TieBreak = breakTie(contest, contestCandidates, result, head.Hash)
+		if contest.IsQuestion() {
+			if contestResult.Motion, sysError = decideMotion(contest, contestCandidates, result, turnout); sysError != nil {
+				return
+			}
+		}
+		res.Contests = append(res.Contests, contestResult)
+		turnout.Contests = append(turnout.Contests, dto.ContestTurnout{
+			ContestID:      contest.ID,
+			Name:           contest.Name,
+			ValidBallots:   result.ValidBallots,
+			BlankBallots:   result.BlankBallots,
+			AbstainBallots: result.AbstainBallots,
+			InvalidBallots: result.InvalidBallots,
+		})
+	}
+	res.Turnout = turnout
+	return
+}
+
+// countedBallots - Ballot yang ikut dihitung, yaitu yang tidak digantikan ballot lain pada pemungutan ulang
+func countedBallots(ballots []entity.Ballot) (counted []entity.Ballot, superseded int) {
+	replaced := make(map[int]bool)
+	for _, ballot := range ballots {
+		if ballot.Supersedes != nil {
+			replaced[*ballot.Supersedes] = true
+		}
+	}
+
+	counted = make([]entity.Ballot, 0, len(ballots))
+	for _, ballot := range ballots {
+		if replaced[ballot.Sequence] {
+			superseded++
+			continue
+		}
+		counted = append(counted, ballot)
+	}
+	return counted, superseded
+}
+
+// decideMotion - Keputusan pertanyaan referendum. Pertanyaan ya/tidak membandingkan suara setuju dengan menolak,
+// pilihan ganda membandingkan jawaban teratas dengan seluruh suara sah lainnya. Semua dihitung berdasarkan bobot suara
+func decideMotion(contest contestEntity.Contest, options []candidateEntity.Candidate, result tally.Result, turnout dto.TurnoutResponse) (*tally.MotionResult, syserror.SysError) {
+	quorum, errQuorum := parseRatio(contest.Quorum)
+	threshold, errThreshold := parseRatio(contest.PassThreshold)
+	if err := errors.Join(errQuorum, errThreshold); err != nil {
+		return nil, syserror.CreateError(err, fiber.StatusInternalServerError, "Ambang pertanyaan "+contest.Name+" tidak valid")
+	}
+
+	votes := make(map[int]int64, len(result.Scores))
+	for _, score := range result.Scores {
+		votes[score.CandidateID] = score.Votes
+	}
+
+	// pemilih yang memilih abstain pada surat suara dihitung bersama jawaban abstain
+	support, against, abstain := int64(0), int64(0), result.AbstainWeight
+	if contest.HasFixedOptions() {
+		for _, option := range options {
+			if option.Answer == nil {
+				continue
+			}
+			switch *option.Answer {
+			case contestEntity.AnswerYes:
+				support += votes[option.ID]
+			case contestEntity.AnswerNo:
+				against += votes[option.ID]
+			case contestEntity.AnswerAbstain:
+				abstain += votes[option.ID]
+			}
+		}
+	} else if len(result.Scores) > 0 {
+		support = result.Scores[0].Votes
+		against = result.ValidWeight - support
+	}
+
+	motion := tally.DecideMotion(support, against, abstain, turnout.TotalVotedWeight, turnout.TotalEligibleWeight, quorum, threshold, contest.QuorumExcludesAbstain)
+	// pilihan ganda yang seri tidak menghasilkan keputusan
+	if !contest.HasFixedOptions() && len(result.Winners) != 1 {
+		motion.Passed = false
+	}
+	return &motion, nil
+}
+
+func parseRatio(value *string) (*tally.Ratio, error) {
+	if value == nil {
+		return nil, nil
+	}
+	ratio, err := tally.ParseRatio(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &ratio, nil
+}
+
+// decryptContents - Ganti ballot terenkripsi dengan isi aslinya,
+// gagal jika kunci election belum direkonstruksi trustee
+func (u *BallotUsecase) decryptContents(ctx fiber.Ctx, electionID int, contents []entity.BallotContent) (sysError syserror.SysError) {
+	indexes := make([]int, 0)
+	ciphertexts := make([]string, 0)
+	for i, content := range contents {
+		if content.Encrypted != "" {
+			indexes = append(indexes, i)
+			ciphertexts = append(ciphertexts, content.Encrypted)
+		}
+	}
+	if len(ciphertexts) == 0 {
+		return
+	}
+
+	plaintexts, sysError := u.trusteeUse.DecryptBallots(ctx, electionID, ciphertexts)
+	if sysError != nil {
+		return
+	}
+	for i, plaintext := range plaintexts {
+		contents[indexes[i]] = entity.BallotContent{}
+		_ = json.Unmarshal(plaintext, &contents[indexes[i]])
+	}
+	return
+}
+
+// IssueStreamToken - Stream token untuk stream live election (admin organization atau saksi),
+// dipakai EventSource sebagai ?stream_token= menggantikan access token
+func (u *BallotUsecase) IssueStreamToken(ctx fiber.Ctx, electionID int) (res dto.StreamTokenResponse, sysError syserror.SysError) {
+	if _, _, sysError = u.electionUse.GetObservableElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	token, err := middleware.GenerateStreamToken(userID, electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat stream token")
+		return
+	}
+
+	res = dto.StreamTokenResponse{
+		ElectionID:  electionID,
+		StreamToken: token.AccessToken,
+		ExpiresAt:   token.ExpiresIn,
+	}
+	return
+}
+
+// SubscribeLive - Langganan event live election (admin organization atau saksi).
+// PubSub dibuat sebelum snapshot pertama dikirim supaya tidak ada suara yang terlewat.
+// withResults false untuk saksi, hasil penghitungan baru dikirim setelah dipublikasikan
+func (u *BallotUsecase) SubscribeLive(ctx fiber.Ctx, electionID int) (res *redis.PubSub, withResults bool, sysError syserror.SysError) {
+	if _, withResults, sysError = u.electionUse.GetObservableElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, err := u.redisDb.SubscribeElectionEvents(ctx.Context(), electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusServiceUnavailable, "Gagal berlangganan update live election")
+	}
+	return
+}
+
+// GetLiveUpdate - Turnout terkini, ditambah hasil penghitungan setelah election ditutup
+// (withResults) atau dipublikasikan. Tidak mengecek hak akses, dipanggil oleh stream yang sudah melewati SubscribeLive
+func (u *BallotUsecase) GetLiveUpdate(ctx fiber.Ctx, electionID int, withResults bool) (res dto.LiveUpdate, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	turnout, sysError := u.ballotRepo.GetTurnout(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.LiveUpdate{
+		ElectionID: election.ID,
+		Status:     election.Status,
+		Turnout:    turnout,
+		UpdatedAt:  helper.Now(),
+	}
+
+	if (withResults && election.Status == electionEntity.StatusClosed) || election.Status == electionEntity.StatusPublished {
+		// ballot terenkripsi yang belum didekripsi trustee: kirim turnout saja
+		results, errCount := u.countResults(ctx, election)
+		switch {
+		case errCount == nil:
+			res.Results = &results
+		case errCount.GetStatusCode() != fiber.StatusConflict:
+			sysError = errCount
+			return
+		}
+	}
+	return
+}
+
+// GetTurnout - Ringkasan jumlah pemilih yang sudah memberikan suara (admin organization atau saksi).
+// Hanya angka agregat, identitas pemilih tidak ikut. Rincian suara sah, kosong, abstain, dan rusak
+// per contest ikut setelah ditutup (admin) atau dipublikasikan, selama ballot sudah bisa dibaca
+func (u *BallotUsecase) GetTurnout(ctx fiber.Ctx, electionID int) (res dto.TurnoutResponse, sysError syserror.SysError) {
+	election, isAdmin, sysError := u.electionUse.GetObservableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	if (isAdmin && election.Status == electionEntity.StatusClosed) || election.Status == electionEntity.StatusPublished {
+		results, errCount := u.countResults(ctx, election)
+		switch {
+		case errCount == nil:
+			res = results.Turnout
+			return
+		case errCount.GetStatusCode() != fiber.StatusConflict:
+			sysError = errCount
+			return
+		}
+	}
+
+	res, sysError = u.ballotRepo.GetTurnout(ctx, electionID)
+	return
+}
+
+// GetVoterParticipations - Status sudah/belum memilih per pemilih (admin organization)
+// Hanya dari buku partisipasi, isi suara tidak pernah ikut
+func (u *BallotUsecase) GetVoterParticipations(ctx fiber.Ctx, electionID int) (res []dto.VoterParticipation, totalRecords int64, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, totalRecords, sysError = u.ballotRepo.GetVoterParticipations(ctx, electionID)
+	return
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/repository"
+	candidateUsecase "github.com/madmuzz05/be-enyoblos/service/module/candidate/usecase"
+	contestUsecase "github.com/madmuzz05/be-enyoblos/service/module/contest/usecase"
+	delegationUsecase "github.com/madmuzz05/be-enyoblos/service/module/delegation/usecase"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	kioskUsecase "github.com/madmuzz05/be-enyoblos/service/module/kiosk/usecase"
+	trusteeUsecase "github.com/madmuzz05/be-enyoblos/service/module/trustee/usecase"
+	voterRollUsecase "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+	votingCodeUsecase "github.com/madmuzz05/be-enyoblos/service/module/votingcode/usecase"
+	"github.com/redis/go-redis/v9"
+)
+
+type BallotUsecase struct {
+	ballotRepo    repository.IBallotRepository
+	electionUse   electionUsecase.IElectionUsecase
+	candidateUse  candidateUsecase.ICandidateUsecase
+	contestUse    contestUsecase.IContestUsecase
+	voterRollUse  voterRollUsecase.IVoterRollUsecase
+	votingCodeUse votingCodeUsecase.IVotingCodeUsecase
+	trusteeUse    trusteeUsecase.ITrusteeUsecase
+	delegationUse delegationUsecase.IDelegationUsecase
+	kioskUse      kioskUsecase.IKioskUsecase
+	redisDb       *redisdb.RedisClient
+	mainDB        *dbpostgres.MainDB
+}
+
+func InitBallotUsecase(ballotRepo repository.IBallotRepository, electionUse electionUsecase.IElectionUsecase, candidateUse candidateUsecase.ICandidateUsecase, contestUse contestUsecase.IContestUsecase, voterRollUse voterRollUsecase.IVoterRollUsecase, votingCodeUse votingCodeUsecase.IVotingCodeUsecase, trusteeUse trusteeUsecase.ITrusteeUsecase, delegationUse delegationUsecase.IDelegationUsecase, kioskUse kioskUsecase.IKioskUsecase, redisDb *redisdb.RedisClient, mainDB *dbpostgres.MainDB) IBallotUsecase {
+	return &BallotUsecase{
+		ballotRepo:    ballotRepo,
+		electionUse:   electionUse,
+		candidateUse:  candidateUse,
+		contestUse:    contestUse,
+		voterRollUse:  voterRollUse,
+		votingCodeUse: votingCodeUse,
+		trusteeUse:    trusteeUse,
+		delegationUse: delegationUse,
+		kioskUse:      kioskUse,
+		redisDb:       redisDb,
+		mainDB:        mainDB,
+	}
+}
+
+type IBallotUsecase interface {
+	CastBallot(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError)
+	CastBallotWithCode(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError)
+	CastBallotWithKiosk(ctx fiber.Ctx, electionID int, req dto.CastBallotRequest) (res dto.BallotReceiptResponse, sysError syserror.SysError)
+	GetBallotPaper(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError)
+	GetBallotPaperWithCode(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError)
+	GetBallotPaperWithKiosk(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError)
+	VerifyReceipt(ctx fiber.Ctx, electionID int, receiptCode string) (res dto.ReceiptVerificationResponse, sysError syserror.SysError)
+	AuditChain(ctx fiber.Ctx, electionID int) (res dto.ChainAuditResponse, sysError syserror.SysError)
+	GetResults(ctx fiber.Ctx, electionID int) (res dto.ElectionResultResponse, sysError syserror.SysError)
+	IssueStreamToken(ctx fiber.Ctx, electionID int) (res dto.StreamTokenResponse, sysError syserror.SysError)
+	SubscribeLive(ctx fiber.Ctx, electionID int) (res *redis.PubSub, withResults bool, sysError syserror.SysError)
+	GetLiveUpdate(ctx fiber.Ctx, electionID int, withResults bool) (res dto.LiveUpdate, sysError syserror.SysError)
+	GetTurnout(ctx fiber.Ctx, electionID int) (res dto.TurnoutResponse, sysError syserror.SysError)
+	GetVoterParticipations(ctx fiber.Ctx, electionID int) (res []dto.VoterParticipation, totalRecords int64, sysError syserror.SysError)
+}
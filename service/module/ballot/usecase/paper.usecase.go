@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/ballotorder"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	candidateEntity "github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+// GetBallotPaper - Surat suara untuk user yang login dan terdaftar di DPT election
+func (u *BallotUsecase) GetBallotPaper(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	voter, sysError := u.voterRollUse.GetVoterRollByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Anda tidak terdaftar dalam DPT election ini")
+		}
+		return
+	}
+
+	res, sysError = u.ballotPaper(ctx, electionID, voter.ID)
+	return
+}
+
+// GetBallotPaperWithCode - Surat suara untuk pemilih tanpa akun memakai ballot token
+func (u *BallotUsecase) GetBallotPaperWithCode(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError) {
+	claims, err := middleware.GetBallotClaims(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "Ballot token tidak valid")
+		return
+	}
+	if claims.ElectionID != electionID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Ballot token bukan untuk election ini")
+		return
+	}
+
+	res, sysError = u.ballotPaper(ctx, electionID, claims.VoterRollID)
+	return
+}
+
+// GetBallotPaperWithKiosk - Surat suara di kiosk TPS untuk pemilih yang surat suaranya sedang dibuka petugas
+func (u *BallotUsecase) GetBallotPaperWithKiosk(ctx fiber.Ctx, electionID int) (res dto.BallotPaperResponse, sysError syserror.SysError) {
+	voterRollID, sysError := u.kioskUse.GetUnlockedVoter(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res, sysError = u.ballotPaper(ctx, electionID, voterRollID)
+	return
+}
+
+// ballotPaper - Susun contest sesuai posisi dan candidate sesuai candidate_order election.
+// Pada urutan random, seed diturunkan dari id election, entri DPT, dan contest sehingga
+// pemilih yang sama selalu melihat urutan yang sama
+func (u *BallotUsecase) ballotPaper(ctx fiber.Ctx, electionID int, voterRollID int) (res dto.BallotPaperResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusScheduled && election.Status != electionEntity.StatusOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Surat suara hanya tersedia saat election dijadwalkan atau dibuka")
+		return
+	}
+
+	contests, sysError := u.contestUse.GetContests(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	candidates, sysError := u.candidateUse.GetCandidates(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	byContest := make(map[int][]candidateEntity.Candidate, len(contests))
+	for _, candidate := range candidates {
+		byContest[candidate.ContestID] = append(byContest[candidate.ContestID], candidate)
+	}
+
+	res = dto.BallotPaperResponse{
+		ElectionID:     election.ID,
+		Title:          election.Title,
+		CandidateOrder: election.CandidateOrder,
+		Contests:       make([]dto.ContestPaper, 0, len(contests)),
+	}
+	for _, contest := range contests {
+		res.Contests = append(res.Contests, dto.ContestPaper{
+			Contest:    contest,
+			Candidates: orderCandidates(election.CandidateOrder, contest, byContest[contest.ID], ballotorder.Seed(election.ID, voterRollID, contest.ID)),
+		})
+	}
+	return
+}
+
+// orderCandidates - Urutkan candidate satu contest, pilihan jawaban tetap (ya/tidak) selalu memakai nomor urut
+func orderCandidates(order string, contest contestEntity.Contest, candidates []candidateEntity.Candidate, seed string) []candidateEntity.Candidate {
+	res := slices.Clone(candidates)
+	if res == nil {
+		return []candidateEntity.Candidate{}
+	}
+	if contest.HasFixedOptions() {
+		order = electionEntity.CandidateOrderBallotNumber
+	}
+
+	switch order {
+	case electionEntity.CandidateOrderFixed:
+		slices.SortFunc(res, func(a, b candidateEntity.Candidate) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+	case electionEntity.CandidateOrderAlphabetical:
+		slices.SortFunc(res, func(a, b candidateEntity.Candidate) int {
+			return cmp.Or(cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)), cmp.Compare(a.ID, b.ID))
+		})
+	case electionEntity.CandidateOrderRandom:
+		// diurutkan dulu supaya hasil acak tidak bergantung pada urutan dari database
+		slices.SortFunc(res, func(a, b candidateEntity.Candidate) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+		shuffled := make([]candidateEntity.Candidate, len(res))
+		for i, index := range ballotorder.Permutation(len(res), seed) {
+			shuffled[i] = res[index]
+		}
+		res = shuffled
+	default:
+		slices.SortFunc(res, func(a, b candidateEntity.Candidate) int {
+			return cmp.Or(cmp.Compare(a.BallotNumber, b.BallotNumber), cmp.Compare(a.ID, b.ID))
+		})
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	"github.com/madmuzz05/be-enyoblos/package/ballotorder"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	candidateEntity "github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+)
+
+// breakTie - Selesaikan seri pada batas kursi terakhir sesuai tie_break contest.
+// Lottery memakai seed hash kepala rantai ballot dan id contest, hash tersebut baru diketahui setelah
+// pemungutan suara ditutup dan bisa dicek siapa pun lewat audit rantai, sehingga hasil undian bisa diulang.
+// Runoff tidak mengisi kursi, kursi menunggu election putaran berikutnya
+func breakTie(contest contestEntity.Contest, candidates []candidateEntity.Candidate, result tally.Result, headHash string) (tally.Result, *dto.TieBreakResult) {
+	if contest.IsQuestion() || len(result.Tied) == 0 {
+		return result, nil
+	}
+
+	tied := slices.Sorted(slices.Values(result.Tied))
+	tieBreak := &dto.TieBreakResult{
+		Policy: contest.TieBreak,
+		Tied:   tied,
+		Seats:  result.Seats - len(result.Winners),
+	}
+
+	switch contest.TieBreak {
+	case contestEntity.TieBreakLottery:
+		tieBreak.Seed = headHash + ":" + strconv.Itoa(contest.ID)
+		tieBreak.Order = make([]int, 0, len(tied))
+		for _, index := range ballotorder.Permutation(len(tied), tieBreak.Seed) {
+			tieBreak.Order = append(tieBreak.Order, tied[index])
+		}
+	case contestEntity.TieBreakEarliestRegistration:
+		registered := make([]candidateEntity.Candidate, 0, len(tied))
+		for _, candidate := range candidates {
+			if slices.Contains(tied, candidate.ID) {
+				registered = append(registered, candidate)
+			}
+		}
+		slices.SortFunc(registered, func(a, b candidateEntity.Candidate) int {
+			return cmp.Or(a.CreatedAt.Compare(b.CreatedAt.Time), cmp.Compare(a.ID, b.ID))
+		})
+		tieBreak.Order = make([]int, 0, len(registered))
+		for _, candidate := range registered {
+			tieBreak.Order = append(tieBreak.Order, candidate.ID)
+		}
+	default:
+		tieBreak.Pending = true
+		tieBreak.Elected = []int{}
+		return result, tieBreak
+	}
+
+	resolved := tally.BreakTie(result, tieBreak.Order)
+	tieBreak.Elected = resolved.Winners[len(result.Winners):]
+	return resolved, tieBreak
+}
@@ -0,0 +1,38 @@
+package dto
+
+const (
+	BallotNumberSequential = "sequential"
+	BallotNumberLottery    = "lottery"
+)
+
+// CreateCandidateRequest - DTO untuk create candidate
+// ballot_number kosong berarti diisi otomatis dengan nomor urut berikutnya pada contest,
+// contest_id boleh kosong jika election hanya memiliki satu contest
+type CreateCandidateRequest struct {
+	ContestID           int     `json:"contest_id" validate:"omitempty,gt=0"`
+	BallotNumber        int     `json:"ballot_number" validate:"omitempty,gt=0"`
+	Name                string  `json:"name" validate:"required,max=255"`
+	PhotoURL            string  `json:"photo_url"`
+	Vision              string  `json:"vision"`
+	Mission             string  `json:"mission"`
+	RunningMateName     *string `json:"running_mate_name" validate:"omitempty,max=255"`
+	RunningMatePhotoURL *string `json:"running_mate_photo_url"`
+}
+
+// UpdateCandidateRequest - DTO untuk update candidate
+// contest_id kosong berarti tetap di contest sebelumnya
+type UpdateCandidateRequest struct {
+	ContestID           int     `json:"contest_id" validate:"omitempty,gt=0"`
+	BallotNumber        int     `json:"ballot_number" validate:"required,gt=0"`
+	Name                string  `json:"name" validate:"required,max=255"`
+	PhotoURL            string  `json:"photo_url"`
+	Vision              string  `json:"vision"`
+	Mission             string  `json:"mission"`
+	RunningMateName     *string `json:"running_mate_name" validate:"omitempty,max=255"`
+	RunningMatePhotoURL *string `json:"running_mate_photo_url"`
+}
+
+// AssignBallotNumbersRequest - DTO untuk penomoran ulang seluruh candidate, nomor dimulai dari 1 pada setiap contest
+type AssignBallotNumbersRequest struct {
+	Method string `json:"method" validate:"required,oneof=sequential lottery"`
+}
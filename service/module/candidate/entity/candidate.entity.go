@@ -0,0 +1,23 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+type Candidate struct {
+	ID                  int               `db:"id" json:"id"`
+	ElectionID          int               `db:"election_id" json:"election_id"`
+	ContestID           int               `db:"contest_id" json:"contest_id"`
+	BallotNumber        int               `db:"ballot_number" json:"ballot_number"`
+	Name                string            `db:"name" json:"name"`
+	PhotoURL            string            `db:"photo_url" json:"photo_url"`
+	Vision              string            `db:"vision" json:"vision"`
+	Mission             string            `db:"mission" json:"mission"`
+	RunningMateName     *string           `db:"running_mate_name" json:"running_mate_name"`
+	RunningMatePhotoURL *string           `db:"running_mate_photo_url" json:"running_mate_photo_url"`
+	Answer              *string           `db:"answer" json:"answer,omitempty"` // yes/no/abstain untuk pilihan jawaban pertanyaan ya/tidak
+	CreatedAt           helper.CustomTime `db:"created_at" json:"created_at"`
+	UpdatedAt           helper.CustomTime `db:"updated_at" json:"updated_at"`
+}
+
+func (Candidate) TableName() string {
+	return "candidates"
+}
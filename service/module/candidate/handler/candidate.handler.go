@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/dto"
+)
+
+// GetCandidates - Get all candidates of an election
+// @GET /elections/:id/candidates
+func (h *CandidateHandler) GetCandidates(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.CandidateUsecase.GetCandidates(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Candidates retrieved successfully", res)
+}
+
+// GetCandidateByID - Get single candidate
+// @GET /elections/:id/candidates/:candidate_id
+func (h *CandidateHandler) GetCandidateByID(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("candidate_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid candidate ID", err)
+	}
+
+	res, sysErr := h.CandidateUsecase.GetCandidateByID(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Candidate retrieved successfully", res)
+}
+
+// CreateCandidate - Create new candidate
+// @POST /elections/:id/candidates
+func (h *CandidateHandler) CreateCandidate(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CreateCandidateRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.CandidateUsecase.CreateCandidate(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Candidate created successfully", res)
+}
+
+// UpdateCandidate - Update existing candidate
+// @PUT /elections/:id/candidates/:candidate_id
+func (h *CandidateHandler) UpdateCandidate(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("candidate_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid candidate ID", err)
+	}
+
+	var req dto.UpdateCandidateRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.CandidateUsecase.UpdateCandidate(ctx, electionID, id, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Candidate updated successfully", res)
+}
+
+// DeleteCandidate - Delete candidate
+// @DELETE /elections/:id/candidates/:candidate_id
+func (h *CandidateHandler) DeleteCandidate(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("candidate_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid candidate ID", err)
+	}
+
+	sysErr := h.CandidateUsecase.DeleteCandidate(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Candidate deleted successfully", nil)
+}
+
+// AssignBallotNumbers - Nomori ulang candidate secara berurutan atau undian
+// @POST /elections/:id/candidates/ballot-numbers
+// Body: {method: "sequential" | "lottery"}
+func (h *CandidateHandler) AssignBallotNumbers(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.AssignBallotNumbersRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.CandidateUsecase.AssignBallotNumbers(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot numbers assigned successfully", res)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/candidate/usecase"
+
+type CandidateHandler struct {
+	CandidateUsecase usecase.ICandidateUsecase
+}
+
+func InitCandidateHandler(candidateUsecase usecase.ICandidateUsecase) *CandidateHandler {
+	return &CandidateHandler{
+		CandidateUsecase: candidateUsecase,
+	}
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+)
+
+const candidateColumns = `id, election_id, contest_id, ballot_number, name, photo_url, vision, mission,
+	running_mate_name, running_mate_photo_url, answer, created_at, updated_at`
+
+func (r *CandidateRepository) GetCandidatesByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Candidate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + candidateColumns + ` FROM public.candidates WHERE election_id = $1 ORDER BY contest_id, ballot_number`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil candidates")
+		return
+	}
+	return
+}
+
+func (r *CandidateRepository) GetCandidateByID(ctx fiber.Ctx, electionID int, id int) (res entity.Candidate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + candidateColumns + ` FROM public.candidates WHERE election_id = $1 AND id = $2`
+
+	model := db.Get(&res, query, electionID, id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Candidate tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil candidate")
+	}
+	return
+}
+
+// GetNextBallotNumber - Nomor urut berikutnya dalam contest untuk penomoran otomatis
+func (r *CandidateRepository) GetNextBallotNumber(ctx fiber.Ctx, contestID int) (number int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COALESCE(MAX(ballot_number), 0) + 1 FROM public.candidates WHERE contest_id = $1`
+
+	model := db.Get(&number, query, contestID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil nomor urut candidate")
+		return
+	}
+	return
+}
+
+// CreateCandidate - Create new candidate
+func (r *CandidateRepository) CreateCandidate(ctx fiber.Ctx, candidate entity.Candidate) (res entity.Candidate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.candidates (election_id, contest_id, ballot_number, name, photo_url, vision, mission,
+	              running_mate_name, running_mate_photo_url, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+	          RETURNING ` + candidateColumns
+
+	model := db.Get(&res, query, candidate.ElectionID, candidate.ContestID, candidate.BallotNumber, candidate.Name, candidate.PhotoURL,
+		candidate.Vision, candidate.Mission, candidate.RunningMateName, candidate.RunningMatePhotoURL, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Nomor urut sudah dipakai candidate lain pada contest ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat candidate")
+	}
+	return
+}
+
+// UpdateCandidate - Update candidate data
+func (r *CandidateRepository) UpdateCandidate(ctx fiber.Ctx, id int, candidate entity.Candidate) (res entity.Candidate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.candidates
+	          SET contest_id = $1, ballot_number = $2, name = $3, photo_url = $4, vision = $5, mission = $6,
+	              running_mate_name = $7, running_mate_photo_url = $8, updated_at = $9
+	          WHERE id = $10 AND election_id = $11
+	          RETURNING ` + candidateColumns
+
+	model := db.Get(&res, query, candidate.ContestID, candidate.BallotNumber, candidate.Name, candidate.PhotoURL, candidate.Vision,
+		candidate.Mission, candidate.RunningMateName, candidate.RunningMatePhotoURL, helper.Now(), id, candidate.ElectionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Candidate tidak ditemukan")
+		return
+	} else if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Nomor urut sudah dipakai candidate lain pada contest ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate candidate")
+	}
+	return
+}
+
+// DeleteCandidate - Delete candidate
+func (r *CandidateRepository) DeleteCandidate(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.candidates WHERE id = $1 AND election_id = $2`
+
+	result, err := db.Exec(query, id, electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus candidate")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Candidate tidak ditemukan")
+		return
+	}
+
+	return
+}
+
+// UpdateBallotNumbers - Set ulang nomor urut banyak candidate dalam satu statement
+// supaya unique constraint (deferrable) hanya dicek setelah semua nomor tertukar
+func (r *CandidateRepository) UpdateBallotNumbers(ctx fiber.Ctx, electionID int, candidateIDs []int, ballotNumbers []int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.candidates c
+	          SET ballot_number = v.ballot_number, updated_at = $2
+	          FROM (SELECT UNNEST($3::int[]) AS id, UNNEST($4::int[]) AS ballot_number) v
+	          WHERE c.id = v.id AND c.election_id = $1`
+
+	_, err := db.Exec(query, electionID, helper.Now(), pq.Array(candidateIDs), pq.Array(ballotNumbers))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengubah nomor urut candidate")
+		return
+	}
+	return
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+)
+
+type CandidateRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitCandidateRepository(mainDB *database.MainDB) ICandidateRepository {
+	return &CandidateRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *CandidateRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type ICandidateRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetCandidatesByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Candidate, sysError syserror.SysError)
+	GetCandidateByID(ctx fiber.Ctx, electionID int, id int) (res entity.Candidate, sysError syserror.SysError)
+	GetNextBallotNumber(ctx fiber.Ctx, contestID int) (number int, sysError syserror.SysError)
+	CreateCandidate(ctx fiber.Ctx, candidate entity.Candidate) (res entity.Candidate, sysError syserror.SysError)
+	UpdateCandidate(ctx fiber.Ctx, id int, candidate entity.Candidate) (res entity.Candidate, sysError syserror.SysError)
+	DeleteCandidate(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	UpdateBallotNumbers(ctx fiber.Ctx, electionID int, candidateIDs []int, ballotNumbers []int) (sysError syserror.SysError)
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+func (u *CandidateUsecase) GetCandidates(ctx fiber.Ctx, electionID int) (res []entity.Candidate, sysError syserror.SysError) {
+	// check if election exists
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.candidateRepo.GetCandidatesByElectionID(ctx, electionID)
+	return
+}
+
+func (u *CandidateUsecase) GetCandidateByID(ctx fiber.Ctx, electionID int, id int) (res entity.Candidate, sysError syserror.SysError) {
+	res, sysError = u.candidateRepo.GetCandidateByID(ctx, electionID, id)
+	return
+}
+
+// CreateCandidate - Create new candidate, hanya saat election masih draft
+func (u *CandidateUsecase) CreateCandidate(ctx fiber.Ctx, electionID int, req dto.CreateCandidateRequest) (res entity.Candidate, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	res, sysError = u.CreateNominatedCandidate(ctx, electionID, req)
+	return
+}
+
+// CreateNominatedCandidate - Sama seperti CreateCandidate tanpa membuka transaction sendiri,
+// dipanggil di dalam transaction persetujuan pendaftaran candidate
+func (u *CandidateUsecase) CreateNominatedCandidate(ctx fiber.Ctx, electionID int, req dto.CreateCandidateRequest) (res entity.Candidate, sysError syserror.SysError) {
+	if sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	contestID, sysError := u.ResolveContestID(ctx, electionID, req.ContestID)
+	if sysError != nil {
+		return
+	}
+
+	// nomor urut otomatis jika tidak diisi
+	ballotNumber := req.BallotNumber
+	if ballotNumber == 0 {
+		ballotNumber, sysError = u.candidateRepo.GetNextBallotNumber(ctx, contestID)
+		if sysError != nil {
+			return
+		}
+	}
+
+	res, sysError = u.candidateRepo.CreateCandidate(ctx, entity.Candidate{
+		ElectionID:          electionID,
+		ContestID:           contestID,
+		BallotNumber:        ballotNumber,
+		Name:                req.Name,
+		PhotoURL:            req.PhotoURL,
+		Vision:              req.Vision,
+		Mission:             req.Mission,
+		RunningMateName:     req.RunningMateName,
+		RunningMatePhotoURL: req.RunningMatePhotoURL,
+	})
+	return
+}
+
+// UpdateCandidate - Update candidate, hanya saat election masih draft
+func (u *CandidateUsecase) UpdateCandidate(ctx fiber.Ctx, electionID int, id int, req dto.UpdateCandidateRequest) (res entity.Candidate, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	candidate, sysError := u.candidateRepo.GetCandidateByID(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if candidate.Answer != nil {
+		sysError = errFixedOption()
+		return
+	}
+
+	contestID := candidate.ContestID
+	if req.ContestID != 0 && req.ContestID != contestID {
+		if contestID, sysError = u.ResolveContestID(ctx, electionID, req.ContestID); sysError != nil {
+			return
+		}
+	}
+
+	res, sysError = u.candidateRepo.UpdateCandidate(ctx, id, entity.Candidate{
+		ElectionID:          electionID,
+		ContestID:           contestID,
+		BallotNumber:        req.BallotNumber,
+		Name:                req.Name,
+		PhotoURL:            req.PhotoURL,
+		Vision:              req.Vision,
+		Mission:             req.Mission,
+		RunningMateName:     req.RunningMateName,
+		RunningMatePhotoURL: req.RunningMatePhotoURL,
+	})
+	return
+}
+
+// DeleteCandidate - Delete candidate, hanya saat election masih draft
+func (u *CandidateUsecase) DeleteCandidate(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	candidate, sysError := u.candidateRepo.GetCandidateByID(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if candidate.Answer != nil {
+		sysError = errFixedOption()
+		return
+	}
+
+	sysError = u.candidateRepo.DeleteCandidate(ctx, electionID, id)
+	return
+}
+
+// AssignBallotNumbers - Nomori ulang seluruh candidate berurutan sesuai waktu pendaftaran atau diundi,
+// setiap contest dinomori terpisah mulai dari 1
+func (u *CandidateUsecase) AssignBallotNumbers(ctx fiber.Ctx, electionID int, req dto.AssignBallotNumbersRequest) (res []entity.Candidate, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	candidates, sysError := u.candidateRepo.GetCandidatesByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if len(candidates) == 0 {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Belum ada candidate untuk dinomori")
+		return
+	}
+
+	// urutan awal berdasarkan waktu pendaftaran (id), jawaban baku ya/tidak tidak ikut dinomori ulang
+	contestCandidates := make(map[int][]int)
+	for _, candidate := range candidates {
+		if candidate.Answer != nil {
+			continue
+		}
+		contestCandidates[candidate.ContestID] = append(contestCandidates[candidate.ContestID], candidate.ID)
+	}
+
+	var candidateIDs, ballotNumbers []int
+	for _, ids := range contestCandidates {
+		slices.Sort(ids)
+
+		if req.Method == dto.BallotNumberLottery {
+			if err := shuffleInts(ids); err != nil {
+				sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengundi nomor urut")
+				return
+			}
+		}
+
+		for i, id := range ids {
+			candidateIDs = append(candidateIDs, id)
+			ballotNumbers = append(ballotNumbers, i+1)
+		}
+	}
+
+	if sysError = u.candidateRepo.UpdateBallotNumbers(ctx, electionID, candidateIDs, ballotNumbers); sysError != nil {
+		return
+	}
+
+	res, sysError = u.candidateRepo.GetCandidatesByElectionID(ctx, electionID)
+	return
+}
+
+// resolveContestID - Pastikan contest milik election dan pilihannya boleh diubah manual.
+// Jika kosong dan election hanya punya satu contest, contest itu yang dipakai
+// ResolveContestID - Contest tujuan candidate: contest_id yang diminta atau satu-satunya contest election.
+// Contest pertanyaan ya/tidak ditolak karena pilihannya ditetapkan otomatis
+func (u *CandidateUsecase) ResolveContestID(ctx fiber.Ctx, electionID int, contestID int) (int, syserror.SysError) {
+	var contest contestEntity.Contest
+	if contestID != 0 {
+		var sysError syserror.SysError
+		if contest, sysError = u.contestUse.GetContestByID(ctx, electionID, contestID); sysError != nil {
+			if sysError.GetStatusCode() == fiber.StatusNotFound {
+				return 0, syserror.CreateError(sysError.GetError(), fiber.StatusBadRequest, "Contest tidak terdaftar pada election ini")
+			}
+			return 0, sysError
+		}
+	} else {
+		contests, sysError := u.contestUse.GetContests(ctx, electionID)
+		if sysError != nil {
+			return 0, sysError
+		}
+		if len(contests) != 1 {
+			return 0, syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "contest_id wajib diisi untuk election dengan lebih dari satu contest")
+		}
+		contest = contests[0]
+	}
+
+	if contest.HasFixedOptions() {
+		return 0, errFixedOption()
+	}
+	return contest.ID, nil
+}
+
+func errFixedOption() syserror.SysError {
+	return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Pilihan jawaban pertanyaan ya/tidak ditetapkan otomatis dan tidak dapat diubah")
+}
+
+// ensureDraftElection - Kunci election, pastikan user admin organization dan status masih draft
+func (u *CandidateUsecase) ensureDraftElection(ctx fiber.Ctx, electionID int) syserror.SysError {
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return sysError
+	}
+
+	if election.Status != electionEntity.StatusDraft {
+		return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Candidate hanya dapat diubah saat election berstatus draft")
+	}
+	return nil
+}
+
+// shuffleInts - Fisher-Yates shuffle memakai crypto/rand agar hasil undian tidak bisa ditebak
+func shuffleInts(values []int) error {
+	for i := len(values) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return err
+		}
+		j := int(n.Int64())
+		values[i], values[j] = values[j], values[i]
+	}
+	return nil
+}
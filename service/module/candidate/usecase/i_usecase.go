@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/repository"
+	contestUsecase "github.com/madmuzz05/be-enyoblos/service/module/contest/usecase"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+)
+
+type CandidateUsecase struct {
+	candidateRepo repository.ICandidateRepository
+	electionUse   electionUsecase.IElectionUsecase
+	contestUse    contestUsecase.IContestUsecase
+	mainDB        *dbpostgres.MainDB
+}
+
+func InitCandidateUsecase(candidateRepo repository.ICandidateRepository, electionUse electionUsecase.IElectionUsecase, contestUse contestUsecase.IContestUsecase, mainDB *dbpostgres.MainDB) ICandidateUsecase {
+	return &CandidateUsecase{
+		candidateRepo: candidateRepo,
+		electionUse:   electionUse,
+		contestUse:    contestUse,
+		mainDB:        mainDB,
+	}
+}
+
+type ICandidateUsecase interface {
+	GetCandidates(ctx fiber.Ctx, electionID int) (res []entity.Candidate, sysError syserror.SysError)
+	GetCandidateByID(ctx fiber.Ctx, electionID int, id int) (res entity.Candidate, sysError syserror.SysError)
+	CreateCandidate(ctx fiber.Ctx, electionID int, req dto.CreateCandidateRequest) (res entity.Candidate, sysError syserror.SysError)
+	CreateNominatedCandidate(ctx fiber.Ctx, electionID int, req dto.CreateCandidateRequest) (res entity.Candidate, sysError syserror.SysError)
+	UpdateCandidate(ctx fiber.Ctx, electionID int, id int, req dto.UpdateCandidateRequest) (res entity.Candidate, sysError syserror.SysError)
+	DeleteCandidate(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	AssignBallotNumbers(ctx fiber.Ctx, electionID int, req dto.AssignBallotNumbersRequest) (res []entity.Candidate, sysError syserror.SysError)
+	ResolveContestID(ctx fiber.Ctx, electionID int, contestID int) (int, syserror.SysError)
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// CertificateResponse - Berita acara beserta tanda tangan dan kunci untuk memverifikasinya
+type CertificateResponse struct {
+	ElectionID int               `json:"election_id"`
+	Document   json.RawMessage   `json:"document"`
+	Text       string            `json:"text"`
+	Signature  string            `json:"signature"`
+	Algorithm  string            `json:"algorithm"`
+	PublicKey  string            `json:"public_key"`
+	KeyID      string            `json:"key_id"`
+	IssuedAt   helper.CustomTime `json:"issued_at"`
+}
+
+// VerifyCertificateRequest - Dokumen yang akan diverifikasi, isi document (JSON) atau text.
+// public_key opsional, default public key server
+type VerifyCertificateRequest struct {
+	Document  json.RawMessage `json:"document"`
+	Text      string          `json:"text"`
+	Signature string          `json:"signature" validate:"required"`
+	PublicKey string          `json:"public_key"`
+}
+
+// VerifyCertificateResponse - Hasil verifikasi tanda tangan berita acara
+type VerifyCertificateResponse struct {
+	Valid         bool   `json:"valid"`
+	KeyID         string `json:"key_id"`
+	ServerKey     bool   `json:"server_key"`     // ditandatangani kunci server ini
+	MatchesIssued bool   `json:"matches_issued"` // sama persis dengan berita acara yang diterbitkan
+	ElectionID    *int   `json:"election_id"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// SigningKeyResponse - Public key server untuk verifikasi berita acara secara mandiri
+type SigningKeyResponse struct {
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+	KeyID     string `json:"key_id"`
+}
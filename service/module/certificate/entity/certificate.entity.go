@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// DocumentVersion - versi format berita acara, naikkan jika isi atau CanonicalText berubah.
+// Versi 2 menambah rincian suara kosong dan abstain per contest, versi 3 penyelesaian seri
+const DocumentVersion = 3
+
+// Certificate - Berita acara hasil election yang sudah ditandatangani.
+// Signature berlaku atas Text, sedangkan Text disusun ulang dari Document
+type Certificate struct {
+	ID         int               `db:"id" json:"id"`
+	ElectionID int               `db:"election_id" json:"election_id"`
+	Document   string            `db:"document" json:"-"`
+	Text       string            `db:"text" json:"-"`
+	Signature  string            `db:"signature" json:"signature"`
+	Algorithm  string            `db:"algorithm" json:"algorithm"`
+	PublicKey  string            `db:"public_key" json:"public_key"`
+	KeyID      string            `db:"key_id" json:"key_id"`
+	IssuedBy   int               `db:"issued_by" json:"issued_by"`
+	IssuedAt   helper.CustomTime `db:"issued_at" json:"issued_at"`
+}
+
+func (Certificate) TableName() string {
+	return "election_certificates"
+}
+
+// Document - Isi berita acara. Seluruh field wajib ikut dirender di CanonicalText,
+// field yang tidak dirender tidak ikut terlindungi tanda tangan
+type Document struct {
+	Version  int               `json:"version"`
+	Election DocumentElection  `json:"election"`
+	Turnout  DocumentTurnout   `json:"turnout"`
+	Chain    DocumentChain     `json:"chain"`
+	Contests []DocumentContest `json:"contests"`
+	IssuedAt string            `json:"issued_at"`
+}
+
+type DocumentElection struct {
+	ID               int    `json:"id"`
+	Title            string `json:"title"`
+	OrganizationID   int    `json:"organization_id"`
+	OrganizationName string `json:"organization_name"`
+	StartAt          string `json:"start_at"`
+	EndAt            string `json:"end_at"`
+	OpenedAt         string `json:"opened_at"`
+	ClosedAt         string `json:"closed_at"`
+}
+
+// DocumentTurnout - Weight hanya terisi jika ada pemilih dengan bobot suara lebih dari 1
+type DocumentTurnout struct {
+	TotalEligible     int64           `json:"total_eligible"`
+	TotalVoted        int64           `json:"total_voted"`
+	TurnoutPercentage float64         `json:"turnout_percentage"`
+	Weight            *DocumentWeight `json:"weight,omitempty"`
+}
+
+// DocumentWeight - Partisipasi berdasarkan bobot suara
+type DocumentWeight struct {
+	TotalEligible    int64   `json:"total_eligible"`
+	TotalVoted       int64   `json:"total_voted"`
+	WeightPercentage float64 `json:"weight_percentage"`
+}
+
+// DocumentChain - Kepala rantai hash ballot saat berita acara dibuat
+type DocumentChain struct {
+	Length   int    `json:"length"`
+	HeadHash string `json:"head_hash"`
+}
+
+// DocumentContest - BlankBallots dan AbstainBallots kosong pada berita acara versi 1,
+// hanya dirender jika ada supaya tanda tangan versi lama tetap valid
+type DocumentContest struct {
+	ID             int                 `json:"id"`
+	Name           string              `json:"name"`
+	VotingMethod   string              `json:"voting_method"`
+	Seats          int                 `json:"seats"`
+	TotalBallots   int64               `json:"total_ballots"`
+	ValidBallots   int64               `json:"valid_ballots"`
+	BlankBallots   *int64              `json:"blank_ballots,omitempty"`
+	AbstainBallots *int64              `json:"abstain_ballots,omitempty"`
+	InvalidBallots int64               `json:"invalid_ballots"`
+	Candidates     []DocumentCandidate `json:"candidates"`
+	Rounds         int                 `json:"rounds"`
+	Motion         *DocumentMotion     `json:"motion,omitempty"`
+	TieBreak       *DocumentTieBreak   `json:"tie_break,omitempty"`
+}
+
+// DocumentTieBreak - Penyelesaian seri pada batas kursi terakhir, Seed terisi untuk lottery
+// sehingga undian bisa diulang dari berita acara
+type DocumentTieBreak struct {
+	Policy  string `json:"policy"`
+	Seed    string `json:"seed"`
+	Order   []int  `json:"order"`
+	Pending bool   `json:"pending"`
+}
+
+// DocumentMotion - Keputusan pertanyaan referendum, QuorumExcludesAbstain kosong pada versi 1
+type DocumentMotion struct {
+	Quorum                string `json:"quorum"`
+	QuorumExcludesAbstain *bool  `json:"quorum_excludes_abstain,omitempty"`
+	QuorumMet             bool   `json:"quorum_met"`
+	Threshold             string `json:"threshold"`
+	Passed                bool   `json:"passed"`
+}
+
+// DocumentCandidate - Perolehan candidate, untuk irv perolehan pada putaran terakhir
+type DocumentCandidate struct {
+	ID           int    `json:"id"`
+	BallotNumber int    `json:"ballot_number"`
+	Name         string `json:"name"`
+	Votes        int64  `json:"votes"`
+	Elected      bool   `json:"elected"`
+	Tied         bool   `json:"tied"`
+}
+
+// CanonicalText - Bentuk teks berita acara yang ditandatangani.
+// Satu field per baris dengan urutan tetap supaya bisa dibaca manusia sekaligus diverifikasi ulang
+func (d Document) CanonicalText() string {
+	var b strings.Builder
+	line := func(key string, value interface{}) {
+		fmt.Fprintf(&b, "%s: %v\n", key, value)
+	}
+
+	b.WriteString("BERITA ACARA HASIL PEMUNGUTAN SUARA\n")
+	line("version", d.Version)
+	line("election.id", d.Election.ID)
+	line("election.title", oneLine(d.Election.Title))
+	line("election.organization_id", d.Election.OrganizationID)
+	line("election.organization_name", oneLine(d.Election.OrganizationName))
+	line("election.start_at", d.Election.StartAt)
+	line("election.end_at", d.Election.EndAt)
+	line("election.opened_at", d.Election.OpenedAt)
+	line("election.closed_at", d.Election.ClosedAt)
+	line("turnout.total_eligible", d.Turnout.TotalEligible)
+	line("turnout.total_voted", d.Turnout.TotalVoted)
+	line("turnout.turnout_percentage", strconv.FormatFloat(d.Turnout.TurnoutPercentage, 'f', -1, 64))
+	if d.Turnout.Weight != nil {
+		line("turnout.weight.total_eligible", d.Turnout.Weight.TotalEligible)
+		line("turnout.weight.total_voted", d.Turnout.Weight.TotalVoted)
+		line("turnout.weight.weight_percentage", strconv.FormatFloat(d.Turnout.Weight.WeightPercentage, 'f', -1, 64))
+	}
+	line("chain.length", d.Chain.Length)
+	line("chain.head_hash", d.Chain.HeadHash)
+	line("contests", len(d.Contests))
+	for i, contest := range d.Contests {
+		prefix := fmt.Sprintf("contest[%d].", i+1)
+		line(prefix+"id", contest.ID)
+		line(prefix+"name", oneLine(contest.Name))
+		line(prefix+"voting_method", contest.VotingMethod)
+		line(prefix+"seats", contest.Seats)
+		line(prefix+"total_ballots", contest.TotalBallots)
+		line(prefix+"valid_ballots", contest.ValidBallots)
+		if contest.BlankBallots != nil {
+			line(prefix+"blank_ballots", *contest.BlankBallots)
+		}
+		if contest.AbstainBallots != nil {
+			line(prefix+"abstain_ballots", *contest.AbstainBallots)
+		}
+		line(prefix+"invalid_ballots", contest.InvalidBallots)
+		line(prefix+"rounds", contest.Rounds)
+		if contest.Motion != nil {
+			line(prefix+"motion.quorum", contest.Motion.Quorum)
+			if contest.Motion.QuorumExcludesAbstain != nil {
+				line(prefix+"motion.quorum_excludes_abstain", *contest.Motion.QuorumExcludesAbstain)
+			}
+			line(prefix+"motion.quorum_met", contest.Motion.QuorumMet)
+			line(prefix+"motion.threshold", contest.Motion.Threshold)
+			line(prefix+"motion.passed", contest.Motion.Passed)
+		}
+		if contest.TieBreak != nil {
+			line(prefix+"tie_break.policy", contest.TieBreak.Policy)
+			line(prefix+"tie_break.seed", contest.TieBreak.Seed)
+			line(prefix+"tie_break.order", contest.TieBreak.Order)
+			line(prefix+"tie_break.pending", contest.TieBreak.Pending)
+		}
+		line(prefix+"candidates", len(contest.Candidates))
+		for j, candidate := range contest.Candidates {
+			line(fmt.Sprintf("%scandidate[%d]", prefix, j+1), fmt.Sprintf("%d | %d | %s | %d | elected=%t | tied=%t",
+				candidate.ID, candidate.BallotNumber, oneLine(candidate.Name), candidate.Votes, candidate.Elected, candidate.Tied))
+		}
+	}
+	line("issued_at", d.IssuedAt)
+	return b.String()
+}
+
+// oneLine - Nilai teks bebas tidak boleh memecah baris, jika tidak dua dokumen berbeda bisa menghasilkan teks yang sama
+func oneLine(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "\r", "\\r", "\n", "\\n").Replace(value)
+}
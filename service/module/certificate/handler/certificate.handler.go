@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/dto"
+)
+
+// IssueCertificate - Terbitkan berita acara hasil election
+// @POST /elections/:id/certificate
+func (h *CertificateHandler) IssueCertificate(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.CertificateUsecase.IssueCertificate(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Certificate issued successfully", res)
+}
+
+// GetCertificate - Berita acara election yang sudah dipublikasikan
+// @GET /elections/:id/certificate
+// Query: format=text untuk teks kanonik, tanda tangan dikirim lewat header X-Certificate-*
+func (h *CertificateHandler) GetCertificate(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.CertificateUsecase.GetCertificate(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	if ctx.Query("format") == "text" {
+		ctx.Set("X-Certificate-Signature", res.Signature)
+		ctx.Set("X-Certificate-Algorithm", res.Algorithm)
+		ctx.Set("X-Certificate-Public-Key", res.PublicKey)
+		ctx.Set(fiber.HeaderContentType, fiber.MIMETextPlainCharsetUTF8)
+		return ctx.Status(fiber.StatusOK).SendString(res.Text)
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Certificate retrieved successfully", res)
+}
+
+// VerifyCertificate - Verifikasi tanda tangan berita acara
+// @POST /certificates/verify
+// Body: {document?: object, text?: string, signature: string, public_key?: string}
+func (h *CertificateHandler) VerifyCertificate(ctx fiber.Ctx) error {
+	var req dto.VerifyCertificateRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.CertificateUsecase.VerifyCertificate(ctx, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Certificate verified", res)
+}
+
+// GetSigningKey - Public key penandatangan berita acara
+// @GET /certificates/public-key
+func (h *CertificateHandler) GetSigningKey(ctx fiber.Ctx) error {
+	res, sysErr := h.CertificateUsecase.GetSigningKey(ctx)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Signing key retrieved successfully", res)
+}
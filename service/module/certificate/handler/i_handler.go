@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/certificate/usecase"
+
+type CertificateHandler struct {
+	CertificateUsecase usecase.ICertificateUsecase
+}
+
+func InitCertificateHandler(certificateUsecase usecase.ICertificateUsecase) *CertificateHandler {
+	return &CertificateHandler{
+		CertificateUsecase: certificateUsecase,
+	}
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/entity"
+)
+
+const certificateColumns = `id, election_id, document, text, signature, algorithm, public_key, key_id, issued_by, issued_at`
+
+func (r *CertificateRepository) GetCertificateByElectionID(ctx fiber.Ctx, electionID int) (res entity.Certificate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + certificateColumns + ` FROM public.election_certificates WHERE election_id = $1`
+
+	model := db.Get(&res, query, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(model, fiber.StatusNotFound, "Berita acara election belum diterbitkan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil berita acara")
+	}
+	return
+}
+
+func (r *CertificateRepository) GetCertificateBySignature(ctx fiber.Ctx, signature string) (res entity.Certificate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + certificateColumns + ` FROM public.election_certificates WHERE signature = $1`
+
+	model := db.Get(&res, query, signature)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(model, fiber.StatusNotFound, "Berita acara tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil berita acara")
+	}
+	return
+}
+
+func (r *CertificateRepository) CreateCertificate(ctx fiber.Ctx, certificate entity.Certificate) (res entity.Certificate, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_certificates (election_id, document, text, signature, algorithm, public_key, key_id, issued_by, issued_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	          RETURNING ` + certificateColumns
+
+	model := db.Get(&res, query, certificate.ElectionID, certificate.Document, certificate.Text, certificate.Signature,
+		certificate.Algorithm, certificate.PublicKey, certificate.KeyID, certificate.IssuedBy, certificate.IssuedAt)
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Berita acara election sudah diterbitkan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan berita acara")
+	}
+	return
+}
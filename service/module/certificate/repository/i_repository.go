@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/entity"
+)
+
+type CertificateRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitCertificateRepository(mainDB *database.MainDB) ICertificateRepository {
+	return &CertificateRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *CertificateRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type ICertificateRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetCertificateByElectionID(ctx fiber.Ctx, electionID int) (res entity.Certificate, sysError syserror.SysError)
+	GetCertificateBySignature(ctx fiber.Ctx, signature string) (res entity.Certificate, sysError syserror.SysError)
+	CreateCertificate(ctx fiber.Ctx, certificate entity.Certificate) (res entity.Certificate, sysError syserror.SysError)
+}
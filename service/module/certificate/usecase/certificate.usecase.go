@@ -0,0 +1,333 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/signer"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	ballotDto "github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+// IssueCertificate - Terbitkan berita acara hasil election yang sudah dipublikasikan (admin organization).
+// Berita acara hanya diterbitkan sekali, setelah masa sanggahan berakhir tanpa sanggahan yang belum diputuskan,
+// dan hanya jika rantai ballot utuh
+func (u *CertificateUsecase) IssueCertificate(ctx fiber.Ctx, electionID int) (res dto.CertificateResponse, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	if u.signer == nil {
+		sysError = syserror.CreateError(signer.ErrInvalidSeed, fiber.StatusServiceUnavailable, "Kunci penandatangan berita acara belum dikonfigurasi")
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Berita acara hanya dapat diterbitkan setelah hasil election dipublikasikan")
+		return
+	}
+	// sanggahan hanya bisa diajukan selama masa sanggahan, jadi berita acara menunggu masa itu berakhir
+	disputeOpen, sysError := u.electionUse.IsDisputeWindowOpen(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if disputeOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Berita acara baru dapat diterbitkan setelah masa sanggahan berakhir")
+		return
+	}
+	if election.UnderDispute {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Berita acara tidak dapat diterbitkan selama masih ada sanggahan yang belum diputuskan")
+		return
+	}
+
+	document, sysError := u.buildDocument(ctx, election)
+	if sysError != nil {
+		return
+	}
+
+	payload, err := json.Marshal(document)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyusun berita acara")
+		return
+	}
+	text := document.CanonicalText()
+
+	certificate, sysError := u.certificateRepo.CreateCertificate(ctx, entity.Certificate{
+		ElectionID: electionID,
+		Document:   string(payload),
+		Text:       text,
+		Signature:  u.signer.Sign([]byte(text)),
+		Algorithm:  signer.Algorithm,
+		PublicKey:  u.signer.PublicKey(),
+		KeyID:      u.signer.KeyID(),
+		IssuedBy:   userID,
+		IssuedAt:   helper.Now(),
+	})
+	if sysError != nil {
+		return
+	}
+
+	res = toCertificateResponse(certificate)
+	return
+}
+
+// buildDocument - Susun isi berita acara dari hasil penghitungan, turnout dan kepala rantai ballot
+func (u *CertificateUsecase) buildDocument(ctx fiber.Ctx, election electionEntity.Election) (res entity.Document, sysError syserror.SysError) {
+	audit, sysError := u.ballotUse.AuditChain(ctx, election.ID)
+	if sysError != nil {
+		return
+	}
+	if !audit.Valid {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Rantai ballot rusak, berita acara tidak dapat diterbitkan: "+audit.Reason)
+		return
+	}
+
+	results, sysError := u.ballotUse.GetResults(ctx, election.ID)
+	if sysError != nil {
+		return
+	}
+
+	turnout, sysError := u.ballotUse.GetTurnout(ctx, election.ID)
+	if sysError != nil {
+		return
+	}
+
+	organization, sysError := u.organizationUse.GetOrganizationByID(ctx, election.OrganizationID)
+	if sysError != nil {
+		return
+	}
+
+	res = entity.Document{
+		Version: entity.DocumentVersion,
+		Election: entity.DocumentElection{
+			ID:               election.ID,
+			Title:            election.Title,
+			OrganizationID:   organization.ID,
+			OrganizationName: organization.Name,
+			StartAt:          timeString(election.StartAt),
+			EndAt:            timeString(election.EndAt),
+			OpenedAt:         timeString(election.OpenedAt),
+			ClosedAt:         timeString(election.ClosedAt),
+		},
+		Turnout: entity.DocumentTurnout{
+			TotalEligible:     turnout.TotalEligible,
+			TotalVoted:        turnout.TotalVoted,
+			TurnoutPercentage: turnout.TurnoutPercentage,
+		},
+		Chain: entity.DocumentChain{
+			Length:   audit.HeadSequence,
+			HeadHash: audit.HeadHash,
+		},
+		Contests: make([]entity.DocumentContest, 0, len(results.Contests)),
+		IssuedAt: helper.Now().ToString(),
+	}
+	if turnout.TotalEligibleWeight != turnout.TotalEligible {
+		res.Turnout.Weight = &entity.DocumentWeight{
+			TotalEligible:    turnout.TotalEligibleWeight,
+			TotalVoted:       turnout.TotalVotedWeight,
+			WeightPercentage: turnout.WeightPercentage,
+		}
+	}
+	for _, contest := range results.Contests {
+		res.Contests = append(res.Contests, documentContest(contest))
+	}
+	return
+}
+
+// GetCertificate - Berita acara election yang sudah dipublikasikan (publik)
+func (u *CertificateUsecase) GetCertificate(ctx fiber.Ctx, electionID int) (res dto.CertificateResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Berita acara election belum dipublikasikan")
+		return
+	}
+
+	certificate, sysError := u.certificateRepo.GetCertificateByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = toCertificateResponse(certificate)
+	return
+}
+
+// VerifyCertificate - Cek tanda tangan berita acara (publik). Jika document yang dikirim,
+// teks kanonik disusun ulang dari document sehingga perubahan sekecil apa pun membuat tanda tangan tidak cocok
+func (u *CertificateUsecase) VerifyCertificate(ctx fiber.Ctx, req dto.VerifyCertificateRequest) (res dto.VerifyCertificateResponse, sysError syserror.SysError) {
+	var text string
+	switch {
+	case len(req.Document) > 0 && string(req.Document) != "null":
+		var document entity.Document
+		decoder := json.NewDecoder(bytes.NewReader(req.Document))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&document); err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Format document berita acara tidak valid")
+			return
+		}
+		text = document.CanonicalText()
+		res.ElectionID = &document.Election.ID
+	case req.Text != "":
+		text = req.Text
+	default:
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Isi document atau text berita acara")
+		return
+	}
+
+	encodedKey := req.PublicKey
+	if encodedKey == "" {
+		if u.signer == nil {
+			sysError = syserror.CreateError(signer.ErrInvalidSeed, fiber.StatusServiceUnavailable, "Kunci penandatangan berita acara belum dikonfigurasi")
+			return
+		}
+		encodedKey = u.signer.PublicKey()
+	}
+	publicKey, err := signer.ParsePublicKey(encodedKey)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Public key harus Ed25519 32 byte (base64)")
+		return
+	}
+
+	res.KeyID = signer.KeyID(publicKey)
+	res.ServerKey = u.signer != nil && encodedKey == u.signer.PublicKey()
+	res.Valid = signer.Verify(publicKey, []byte(text), req.Signature)
+	if !res.Valid {
+		res.Reason = "Tanda tangan tidak cocok dengan isi berita acara"
+		return
+	}
+
+	certificate, errIssued := u.certificateRepo.GetCertificateBySignature(ctx, req.Signature)
+	if errIssued != nil && errIssued.GetStatusCode() != fiber.StatusNotFound {
+		sysError = errIssued
+		return
+	}
+	if errIssued == nil && certificate.Text == text {
+		res.MatchesIssued = true
+		res.ElectionID = &certificate.ElectionID
+	}
+	return
+}
+
+// GetSigningKey - Public key penandatangan berita acara (publik)
+func (u *CertificateUsecase) GetSigningKey(ctx fiber.Ctx) (res dto.SigningKeyResponse, sysError syserror.SysError) {
+	if u.signer == nil {
+		sysError = syserror.CreateError(signer.ErrInvalidSeed, fiber.StatusServiceUnavailable, "Kunci penandatangan berita acara belum dikonfigurasi")
+		return
+	}
+
+	res = dto.SigningKeyResponse{
+		Algorithm: signer.Algorithm,
+		PublicKey: u.signer.PublicKey(),
+		KeyID:     u.signer.KeyID(),
+	}
+	return
+}
+
+func documentContest(contest ballotDto.ContestResult) entity.DocumentContest {
+	votes := make(map[int]int64, len(contest.Result.Scores))
+	for _, score := range contest.Result.Scores {
+		votes[score.CandidateID] = score.Votes
+	}
+
+	res := entity.DocumentContest{
+		ID:             contest.Contest.ID,
+		Name:           contest.Contest.Name,
+		VotingMethod:   contest.Contest.VotingMethod,
+		Seats:          contest.Contest.Seats,
+		TotalBallots:   contest.Result.TotalBallots,
+		ValidBallots:   contest.Result.ValidBallots,
+		BlankBallots:   &contest.Result.BlankBallots,
+		AbstainBallots: &contest.Result.AbstainBallots,
+		InvalidBallots: contest.Result.InvalidBallots,
+		Candidates:     make([]entity.DocumentCandidate, 0, len(contest.Candidates)),
+		Rounds:         len(contest.Result.Rounds),
+	}
+	if motion := contest.Motion; motion != nil {
+		res.Motion = &entity.DocumentMotion{
+			Quorum:                ratioString(motion.Quorum),
+			QuorumExcludesAbstain: &motion.QuorumExcludesAbstain,
+			QuorumMet:             motion.QuorumMet,
+			Threshold:             ratioString(motion.Threshold),
+			Passed:                motion.Passed,
+		}
+	}
+	if tieBreak := contest.TieBreak; tieBreak != nil {
+		res.TieBreak = &entity.DocumentTieBreak{
+			Policy:  tieBreak.Policy,
+			Seed:    tieBreak.Seed,
+			Order:   tieBreak.Order,
+			Pending: tieBreak.Pending,
+		}
+	}
+	for _, candidate := range contest.Candidates {
+		res.Candidates = append(res.Candidates, entity.DocumentCandidate{
+			ID:           candidate.ID,
+			BallotNumber: candidate.BallotNumber,
+			Name:         candidate.Name,
+			Votes:        votes[candidate.ID],
+			Elected:      slices.Contains(contest.Result.Winners, candidate.ID),
+			Tied:         slices.Contains(contest.Result.Tied, candidate.ID),
+		})
+	}
+	return res
+}
+
+func toCertificateResponse(certificate entity.Certificate) dto.CertificateResponse {
+	return dto.CertificateResponse{
+		ElectionID: certificate.ElectionID,
+		Document:   json.RawMessage(certificate.Document),
+		Text:       certificate.Text,
+		Signature:  certificate.Signature,
+		Algorithm:  certificate.Algorithm,
+		PublicKey:  certificate.PublicKey,
+		KeyID:      certificate.KeyID,
+		IssuedAt:   certificate.IssuedAt,
+	}
+}
+
+// ratioString - Ambang kosong ditulis "-" (quorum tidak dipakai / mayoritas sederhana)
+func ratioString(ratio *tally.Ratio) string {
+	if ratio == nil {
+		return "-"
+	}
+	return ratio.String()
+}
+
+func timeString(t *helper.CustomTime) string {
+	if t == nil {
+		return ""
+	}
+	return t.ToString()
+}
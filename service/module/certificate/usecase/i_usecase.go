@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/signer"
+	ballotUsecase "github.com/madmuzz05/be-enyoblos/service/module/ballot/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/repository"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	organizationUsecase "github.com/madmuzz05/be-enyoblos/service/module/organization/usecase"
+)
+
+type CertificateUsecase struct {
+	certificateRepo repository.ICertificateRepository
+	electionUse     electionUsecase.IElectionUsecase
+	organizationUse organizationUsecase.IOrganizationUsecase
+	ballotUse       ballotUsecase.IBallotUsecase
+	signer          *signer.Signer // nil jika CERTIFICATE_SIGNING_KEY belum diisi
+	mainDB          *dbpostgres.MainDB
+}
+
+func InitCertificateUsecase(certificateRepo repository.ICertificateRepository, electionUse electionUsecase.IElectionUsecase, organizationUse organizationUsecase.IOrganizationUsecase, ballotUse ballotUsecase.IBallotUsecase, signer *signer.Signer, mainDB *dbpostgres.MainDB) ICertificateUsecase {
+	return &CertificateUsecase{
+		certificateRepo: certificateRepo,
+		electionUse:     electionUse,
+		organizationUse: organizationUse,
+		ballotUse:       ballotUse,
+		signer:          signer,
+		mainDB:          mainDB,
+	}
+}
+
+type ICertificateUsecase interface {
+	IssueCertificate(ctx fiber.Ctx, electionID int) (res dto.CertificateResponse, sysError syserror.SysError)
+	GetCertificate(ctx fiber.Ctx, electionID int) (res dto.CertificateResponse, sysError syserror.SysError)
+	VerifyCertificate(ctx fiber.Ctx, req dto.VerifyCertificateRequest) (res dto.VerifyCertificateResponse, sysError syserror.SysError)
+	GetSigningKey(ctx fiber.Ctx) (res dto.SigningKeyResponse, sysError syserror.SysError)
+}
@@ -0,0 +1,33 @@
+package dto
+
+// CreateContestRequest - DTO untuk create contest
+// seats kosong berarti 1, voting_method kosong mengikuti election, position kosong berarti paling akhir.
+// Pada referendum contest adalah pertanyaan: answer_type kosong berarti yes_no_abstain, options hanya untuk
+// multiple_choice, quorum dan pass_threshold berbentuk pecahan "a/b",
+// quorum_excludes_abstain tidak menghitung pemilih yang abstain sebagai hadir, tie_break kosong berarti runoff
+type CreateContestRequest struct {
+	Name                  string   `json:"name" validate:"required,max=255"`
+	Seats                 int      `json:"seats" validate:"omitempty,gt=0"`
+	VotingMethod          string   `json:"voting_method" validate:"omitempty,oneof=plurality approval irv borda"`
+	Position              int      `json:"position" validate:"omitempty,gt=0"`
+	Description           string   `json:"description"`
+	AnswerType            string   `json:"answer_type" validate:"omitempty,oneof=yes_no yes_no_abstain multiple_choice"`
+	Options               []string `json:"options" validate:"omitempty,dive,required,max=255"`
+	Quorum                *string  `json:"quorum"`
+	PassThreshold         *string  `json:"pass_threshold"`
+	QuorumExcludesAbstain bool     `json:"quorum_excludes_abstain"` // hanya untuk pertanyaan dengan quorum
+	TieBreak              string   `json:"tie_break" validate:"omitempty,oneof=runoff lottery earliest_registration"`
+}
+
+// UpdateContestRequest - DTO untuk update contest, answer_type pertanyaan tidak dapat diubah
+type UpdateContestRequest struct {
+	Name                  string  `json:"name" validate:"required,max=255"`
+	Seats                 int     `json:"seats" validate:"required,gt=0"`
+	VotingMethod          string  `json:"voting_method" validate:"required,oneof=plurality approval irv borda"`
+	Position              int     `json:"position" validate:"required,gt=0"`
+	Description           string  `json:"description"`
+	Quorum                *string `json:"quorum"`
+	PassThreshold         *string `json:"pass_threshold"`
+	QuorumExcludesAbstain bool    `json:"quorum_excludes_abstain"`                                                   // hanya untuk pertanyaan dengan quorum
+	TieBreak              string  `json:"tie_break" validate:"omitempty,oneof=runoff lottery earliest_registration"` // kosong berarti runoff
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	KindCandidate = "candidate"
+	KindQuestion  = "question"
+)
+
+// Jenis jawaban pertanyaan referendum
+const (
+	AnswerTypeYesNo          = "yes_no"
+	AnswerTypeYesNoAbstain   = "yes_no_abstain"
+	AnswerTypeMultipleChoice = "multiple_choice"
+)
+
+// Jawaban baku pertanyaan ya/tidak, disimpan pada candidates.answer
+const (
+	AnswerYes     = "yes"
+	AnswerNo      = "no"
+	AnswerAbstain = "abstain"
+)
+
+// Penyelesaian seri pada batas kursi terakhir
+const (
+	TieBreakRunoff               = "runoff"                // kursi menunggu election putaran berikutnya
+	TieBreakLottery              = "lottery"               // diundi dengan seed dari hash kepala rantai ballot
+	TieBreakEarliestRegistration = "earliest_registration" // candidate yang lebih dulu terdaftar
+)
+
+// Contest - Satu jabatan/posisi dalam election, dengan candidate, jumlah kursi dan metode penghitungan sendiri.
+// Pada referendum contest berupa pertanyaan (kind question) dan candidate-nya adalah pilihan jawaban
+type Contest struct {
+	ID                    int               `db:"id" json:"id"`
+	ElectionID            int               `db:"election_id" json:"election_id"`
+	Name                  string            `db:"name" json:"name"`
+	Seats                 int               `db:"seats" json:"seats"`
+	VotingMethod          string            `db:"voting_method" json:"voting_method"`
+	Position              int               `db:"position" json:"position"`
+	Kind                  string            `db:"kind" json:"kind"`
+	Description           string            `db:"description" json:"description"`
+	AnswerType            *string           `db:"answer_type" json:"answer_type"`
+	Quorum                *string           `db:"quorum" json:"quorum"`
+	PassThreshold         *string           `db:"pass_threshold" json:"pass_threshold"`
+	QuorumExcludesAbstain bool              `db:"quorum_excludes_abstain" json:"quorum_excludes_abstain"` // pemilih yang abstain tidak dihitung hadir untuk quorum
+	TieBreak              string            `db:"tie_break" json:"tie_break"`
+	CreatedAt             helper.CustomTime `db:"created_at" json:"created_at"`
+	UpdatedAt             helper.CustomTime `db:"updated_at" json:"updated_at"`
+}
+
+func (Contest) TableName() string {
+	return "contests"
+}
+
+// IsQuestion - Contest berupa pertanyaan referendum
+func (c Contest) IsQuestion() bool {
+	return c.Kind == KindQuestion
+}
+
+// HasFixedOptions - Pilihan jawaban dibuat otomatis dan tidak boleh diubah manual
+func (c Contest) HasFixedOptions() bool {
+	return c.AnswerType != nil && *c.AnswerType != AnswerTypeMultipleChoice
+}
+
+// AnswerOption - Satu pilihan jawaban pertanyaan
+type AnswerOption struct {
+	Name   string
+	Answer string // kosong untuk pilihan ganda
+}
+
+// FixedOptions - Pilihan jawaban baku untuk pertanyaan ya/tidak sesuai urutan pada surat suara
+func FixedOptions(answerType string) []AnswerOption {
+	switch answerType {
+	case AnswerTypeYesNo:
+		return []AnswerOption{{Name: "Setuju", Answer: AnswerYes}, {Name: "Tidak Setuju", Answer: AnswerNo}}
+	case AnswerTypeYesNoAbstain:
+		return []AnswerOption{{Name: "Setuju", Answer: AnswerYes}, {Name: "Tidak Setuju", Answer: AnswerNo}, {Name: "Abstain", Answer: AnswerAbstain}}
+	}
+	return nil
+}
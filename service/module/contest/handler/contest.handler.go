@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/dto"
+)
+
+// GetContests - Get all contests of an election sesuai urutan pada surat suara
+// @GET /elections/:id/contests
+func (h *ContestHandler) GetContests(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.ContestUsecase.GetContests(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Contests retrieved successfully", res)
+}
+
+// GetContestByID - Get single contest
+// @GET /elections/:id/contests/:contest_id
+func (h *ContestHandler) GetContestByID(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("contest_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid contest ID", err)
+	}
+
+	res, sysErr := h.ContestUsecase.GetContestByID(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Contest retrieved successfully", res)
+}
+
+// CreateContest - Create new contest
+// @POST /elections/:id/contests
+func (h *ContestHandler) CreateContest(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CreateContestRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.ContestUsecase.CreateContest(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Contest created successfully", res)
+}
+
+// UpdateContest - Update existing contest
+// @PUT /elections/:id/contests/:contest_id
+func (h *ContestHandler) UpdateContest(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("contest_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid contest ID", err)
+	}
+
+	var req dto.UpdateContestRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.ContestUsecase.UpdateContest(ctx, electionID, id, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Contest updated successfully", res)
+}
+
+// DeleteContest - Delete contest beserta candidate-nya
+// @DELETE /elections/:id/contests/:contest_id
+func (h *ContestHandler) DeleteContest(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("contest_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid contest ID", err)
+	}
+
+	sysErr := h.ContestUsecase.DeleteContest(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Contest deleted successfully", nil)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/contest/usecase"
+
+type ContestHandler struct {
+	ContestUsecase usecase.IContestUsecase
+}
+
+func InitContestHandler(contestUsecase usecase.IContestUsecase) *ContestHandler {
+	return &ContestHandler{
+		ContestUsecase: contestUsecase,
+	}
+}
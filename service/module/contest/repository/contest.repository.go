@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+)
+
+const contestColumns = `id, election_id, name, seats, voting_method, position, kind, description, answer_type, quorum,
+	pass_threshold, quorum_excludes_abstain, tie_break, created_at, updated_at`
+
+func (r *ContestRepository) GetContestsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Contest, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + contestColumns + ` FROM public.contests WHERE election_id = $1 ORDER BY position, id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil contests")
+		return
+	}
+	return
+}
+
+func (r *ContestRepository) GetContestByID(ctx fiber.Ctx, electionID int, id int) (res entity.Contest, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + contestColumns + ` FROM public.contests WHERE election_id = $1 AND id = $2`
+
+	model := db.Get(&res, query, electionID, id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Contest tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil contest")
+	}
+	return
+}
+
+// GetNextPosition - Urutan berikutnya untuk contest baru pada surat suara
+func (r *ContestRepository) GetNextPosition(ctx fiber.Ctx, electionID int) (position int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COALESCE(MAX(position), 0) + 1 FROM public.contests WHERE election_id = $1`
+
+	model := db.Get(&position, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil urutan contest")
+		return
+	}
+	return
+}
+
+// CreateContest - Create new contest beserta pilihan jawaban awal (khusus pertanyaan referendum)
+func (r *ContestRepository) CreateContest(ctx fiber.Ctx, contest entity.Contest, options []entity.AnswerOption) (res entity.Contest, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	names := make([]string, 0, len(options))
+	answers := make([]string, 0, len(options))
+	for _, option := range options {
+		names = append(names, option.Name)
+		answers = append(answers, option.Answer)
+	}
+
+	query := `WITH contest AS (
+	              INSERT INTO public.contests (election_id, name, seats, voting_method, position, kind, description, answer_type,
+	                  quorum, pass_threshold, created_at, updated_at, quorum_excludes_abstain, tie_break)
+	              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11, $14, $15)
+	              RETURNING ` + contestColumns + `
+	          ), options AS (
+	              INSERT INTO public.candidates (election_id, contest_id, ballot_number, name, answer, created_at, updated_at)
+	              SELECT c.election_id, c.id, o.ordinality, o.name, NULLIF(o.answer, ''), c.created_at, c.created_at
+	              FROM contest c, UNNEST($12::text[], $13::text[]) WITH ORDINALITY AS o(name, answer, ordinality)
+	          )
+	          SELECT ` + contestColumns + ` FROM contest`
+
+	model := db.Get(&res, query, contest.ElectionID, contest.Name, contest.Seats, contest.VotingMethod, contest.Position,
+		contest.Kind, contest.Description, contest.AnswerType, contest.Quorum, contest.PassThreshold, helper.Now(),
+		pq.Array(names), pq.Array(answers), contest.QuorumExcludesAbstain, contest.TieBreak)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat contest")
+		return
+	}
+	return
+}
+
+// UpdateContest - Update contest data
+func (r *ContestRepository) UpdateContest(ctx fiber.Ctx, id int, contest entity.Contest) (res entity.Contest, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.contests
+	          SET name = $1, seats = $2, voting_method = $3, position = $4, description = $5, quorum = $6, pass_threshold = $7,
+	              updated_at = $8, quorum_excludes_abstain = $11, tie_break = $12
+	          WHERE id = $9 AND election_id = $10
+	          RETURNING ` + contestColumns
+
+	model := db.Get(&res, query, contest.Name, contest.Seats, contest.VotingMethod, contest.Position, contest.Description,
+		contest.Quorum, contest.PassThreshold, helper.Now(), id, contest.ElectionID, contest.QuorumExcludesAbstain, contest.TieBreak)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Contest tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate contest")
+	}
+	return
+}
+
+// DeleteContest - Delete contest beserta candidate-nya
+func (r *ContestRepository) DeleteContest(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.contests WHERE id = $1 AND election_id = $2`
+
+	result, err := db.Exec(query, id, electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus contest")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Contest tidak ditemukan")
+		return
+	}
+
+	return
+}
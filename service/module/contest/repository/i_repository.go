@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+)
+
+type ContestRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitContestRepository(mainDB *database.MainDB) IContestRepository {
+	return &ContestRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *ContestRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IContestRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetContestsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Contest, sysError syserror.SysError)
+	GetContestByID(ctx fiber.Ctx, electionID int, id int) (res entity.Contest, sysError syserror.SysError)
+	GetNextPosition(ctx fiber.Ctx, electionID int) (position int, sysError syserror.SysError)
+	CreateContest(ctx fiber.Ctx, contest entity.Contest, options []entity.AnswerOption) (res entity.Contest, sysError syserror.SysError)
+	UpdateContest(ctx fiber.Ctx, id int, contest entity.Contest) (res entity.Contest, sysError syserror.SysError)
+	DeleteContest(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+func (u *ContestUsecase) GetContests(ctx fiber.Ctx, electionID int) (res []entity.Contest, sysError syserror.SysError) {
+	// check if election exists
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.contestRepo.GetContestsByElectionID(ctx, electionID)
+	return
+}
+
+func (u *ContestUsecase) GetContestByID(ctx fiber.Ctx, electionID int, id int) (res entity.Contest, sysError syserror.SysError) {
+	res, sysError = u.contestRepo.GetContestByID(ctx, electionID, id)
+	return
+}
+
+// CreateContest - Tambah contest (jabatan) baru, hanya saat election masih draft
+func (u *ContestUsecase) CreateContest(ctx fiber.Ctx, electionID int, req dto.CreateContestRequest) (res entity.Contest, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureDraftElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	contest := entity.Contest{
+		ElectionID:            electionID,
+		Name:                  req.Name,
+		Seats:                 req.Seats,
+		VotingMethod:          req.VotingMethod,
+		Position:              req.Position,
+		Kind:                  entity.KindCandidate,
+		Description:           req.Description,
+		Quorum:                req.Quorum,
+		PassThreshold:         req.PassThreshold,
+		QuorumExcludesAbstain: req.QuorumExcludesAbstain,
+		TieBreak:              tieBreakOrDefault(req.TieBreak),
+	}
+	if contest.Seats == 0 {
+		contest.Seats = 1
+	}
+	if contest.VotingMethod == "" {
+		contest.VotingMethod = election.VotingMethod
+	}
+
+	var options []entity.AnswerOption
+	if election.Type == electionEntity.TypeReferendum {
+		contest.Kind = entity.KindQuestion
+		answerType := req.AnswerType
+		if answerType == "" {
+			answerType = entity.AnswerTypeYesNoAbstain
+		}
+		contest.AnswerType = &answerType
+
+		options = entity.FixedOptions(answerType)
+		if answerType == entity.AnswerTypeMultipleChoice {
+			for _, name := range req.Options {
+				options = append(options, entity.AnswerOption{Name: name})
+			}
+		} else {
+			// pertanyaan ya/tidak selalu satu jawaban
+			contest.Seats = 1
+			contest.VotingMethod = string(tally.MethodPlurality)
+			if len(req.Options) > 0 {
+				sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "options hanya untuk pertanyaan multiple_choice")
+				return
+			}
+		}
+	} else if req.AnswerType != "" || len(req.Options) > 0 {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "answer_type dan options hanya untuk referendum")
+		return
+	}
+
+	if sysError = validateQuestionRules(contest); sysError != nil {
+		return
+	}
+
+	if contest.Position == 0 {
+		contest.Position, sysError = u.contestRepo.GetNextPosition(ctx, electionID)
+		if sysError != nil {
+			return
+		}
+	}
+
+	res, sysError = u.contestRepo.CreateContest(ctx, contest, options)
+	return
+}
+
+// UpdateContest - Update contest, hanya saat election masih draft
+func (u *ContestUsecase) UpdateContest(ctx fiber.Ctx, electionID int, id int, req dto.UpdateContestRequest) (res entity.Contest, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	contest, sysError := u.contestRepo.GetContestByID(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	contest.Name = req.Name
+	contest.Seats = req.Seats
+	contest.VotingMethod = req.VotingMethod
+	contest.Position = req.Position
+	contest.Description = req.Description
+	contest.Quorum = req.Quorum
+	contest.PassThreshold = req.PassThreshold
+	contest.QuorumExcludesAbstain = req.QuorumExcludesAbstain
+	contest.TieBreak = tieBreakOrDefault(req.TieBreak)
+	if contest.HasFixedOptions() && (contest.Seats != 1 || contest.VotingMethod != string(tally.MethodPlurality)) {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Pertanyaan ya/tidak harus memakai plurality dengan 1 kursi")
+		return
+	}
+	if sysError = validateQuestionRules(contest); sysError != nil {
+		return
+	}
+
+	res, sysError = u.contestRepo.UpdateContest(ctx, id, contest)
+	return
+}
+
+// DeleteContest - Delete contest beserta candidate-nya, hanya saat election masih draft.
+// Contest terakhir tidak boleh dihapus karena surat suara harus berisi minimal satu contest
+func (u *ContestUsecase) DeleteContest(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureDraftElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	contests, sysError := u.contestRepo.GetContestsByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if len(contests) <= 1 {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election harus memiliki minimal satu contest")
+		return
+	}
+
+	sysError = u.contestRepo.DeleteContest(ctx, electionID, id)
+	return
+}
+
+// ensureDraftElection - Kunci election, pastikan user admin organization dan status masih draft
+func (u *ContestUsecase) ensureDraftElection(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	if election.Status != electionEntity.StatusDraft {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Contest hanya dapat diubah saat election berstatus draft")
+	}
+	return
+}
+
+// validateQuestionRules - quorum dan pass_threshold hanya untuk pertanyaan dan harus berbentuk pecahan a/b.
+// Pertanyaan dengan ambang hanya boleh memilih satu jawaban supaya perbandingan setuju/menolak bermakna
+func validateQuestionRules(contest entity.Contest) syserror.SysError {
+	if contest.QuorumExcludesAbstain && contest.Quorum == nil {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "quorum_excludes_abstain hanya berlaku jika quorum diatur")
+	}
+	if contest.Quorum == nil && contest.PassThreshold == nil {
+		return nil
+	}
+	if !contest.IsQuestion() {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "quorum dan pass_threshold hanya untuk pertanyaan referendum")
+	}
+	if contest.PassThreshold != nil && (contest.Seats != 1 || contest.VotingMethod != string(tally.MethodPlurality)) {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "pass_threshold hanya untuk pertanyaan dengan satu jawaban (plurality, 1 kursi)")
+	}
+	for field, value := range map[string]*string{"quorum": contest.Quorum, "pass_threshold": contest.PassThreshold} {
+		if value == nil {
+			continue
+		}
+		if _, err := tally.ParseRatio(*value); err != nil {
+			return syserror.CreateError(err, fiber.StatusBadRequest, field+" harus berbentuk pecahan a/b, mis. 2/3")
+		}
+	}
+	return nil
+}
+
+// tieBreakOrDefault - penyelesaian seri yang tidak diatur memakai runoff
+func tieBreakOrDefault(policy string) string {
+	if policy == "" {
+		return entity.TieBreakRunoff
+	}
+	return policy
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/repository"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+)
+
+type ContestUsecase struct {
+	contestRepo repository.IContestRepository
+	electionUse electionUsecase.IElectionUsecase
+	mainDB      *dbpostgres.MainDB
+}
+
+func InitContestUsecase(contestRepo repository.IContestRepository, electionUse electionUsecase.IElectionUsecase, mainDB *dbpostgres.MainDB) IContestUsecase {
+	return &ContestUsecase{
+		contestRepo: contestRepo,
+		electionUse: electionUse,
+		mainDB:      mainDB,
+	}
+}
+
+type IContestUsecase interface {
+	GetContests(ctx fiber.Ctx, electionID int) (res []entity.Contest, sysError syserror.SysError)
+	GetContestByID(ctx fiber.Ctx, electionID int, id int) (res entity.Contest, sysError syserror.SysError)
+	CreateContest(ctx fiber.Ctx, electionID int, req dto.CreateContestRequest) (res entity.Contest, sysError syserror.SysError)
+	UpdateContest(ctx fiber.Ctx, electionID int, id int, req dto.UpdateContestRequest) (res entity.Contest, sysError syserror.SysError)
+	DeleteContest(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
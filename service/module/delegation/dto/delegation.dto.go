@@ -0,0 +1,16 @@
+package dto
+
+import "github.com/madmuzz05/be-enyoblos/service/module/delegation/entity"
+
+// CreateDelegationRequest - DTO untuk menguasakan suara, proxy berisi email atau nomor anggota penerima kuasa
+type CreateDelegationRequest struct {
+	Proxy string `json:"proxy" validate:"required,max=255"`
+}
+
+// MyDelegationsResponse - Delegasi milik pemilih yang login: kuasa yang diberikan dan kuasa yang dipegang
+type MyDelegationsResponse struct {
+	ElectionID int                       `json:"election_id"`
+	ProxyLimit int                       `json:"proxy_limit"`
+	Given      *entity.DelegationDetail  `json:"given"`
+	Held       []entity.DelegationDetail `json:"held"`
+}
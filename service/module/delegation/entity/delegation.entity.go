@@ -0,0 +1,56 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	StatusActive  = "active"
+	StatusRevoked = "revoked"
+	StatusUsed    = "used" // proxy sudah memberikan suara atas nama delegator
+)
+
+const (
+	EventCreated = "created"
+	EventRevoked = "revoked"
+	EventUsed    = "used" // dicatat sekaligus saat election ditutup, bukan saat suara disimpan
+)
+
+// Delegation - Kuasa suara dari satu pemilih DPT (delegator) kepada pemilih DPT lain (proxy) pada satu election.
+// Bisa dicabut selama belum dipakai proxy dan delegator belum memberikan suara sendiri
+type Delegation struct {
+	ID                   int                `db:"id" json:"id"`
+	ElectionID           int                `db:"election_id" json:"election_id"`
+	DelegatorVoterRollID int                `db:"delegator_voter_roll_id" json:"delegator_voter_roll_id"`
+	ProxyVoterRollID     int                `db:"proxy_voter_roll_id" json:"proxy_voter_roll_id"`
+	Status               string             `db:"status" json:"status"`
+	CreatedAt            helper.CustomTime  `db:"created_at" json:"created_at"`
+	RevokedAt            *helper.CustomTime `db:"revoked_at" json:"revoked_at"`
+}
+
+func (Delegation) TableName() string {
+	return "election_delegations"
+}
+
+// DelegationDetail - Delegation beserta identitas delegator dan proxy
+type DelegationDetail struct {
+	Delegation
+	DelegatorName  string `db:"delegator_name" json:"delegator_name"`
+	DelegatorEmail string `db:"delegator_email" json:"delegator_email"`
+	ProxyName      string `db:"proxy_name" json:"proxy_name"`
+	ProxyEmail     string `db:"proxy_email" json:"proxy_email"`
+}
+
+// DelegationEvent - Satu kejadian pada jejak audit delegasi. ActorUserID kosong jika user sudah dihapus
+type DelegationEvent struct {
+	ID                   int               `db:"id" json:"id"`
+	ElectionID           int               `db:"election_id" json:"election_id"`
+	DelegationID         int               `db:"delegation_id" json:"delegation_id"`
+	DelegatorVoterRollID int               `db:"delegator_voter_roll_id" json:"delegator_voter_roll_id"`
+	ProxyVoterRollID     int               `db:"proxy_voter_roll_id" json:"proxy_voter_roll_id"`
+	Event                string            `db:"event" json:"event"`
+	ActorUserID          *int              `db:"actor_user_id" json:"actor_user_id"`
+	CreatedAt            helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (DelegationEvent) TableName() string {
+	return "election_delegation_events"
+}
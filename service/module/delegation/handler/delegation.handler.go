@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/dto"
+)
+
+// GetDelegations - Seluruh delegasi election (admin)
+// @GET /elections/:id/delegations
+func (h *DelegationHandler) GetDelegations(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.DelegationUsecase.GetDelegations(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Delegations retrieved successfully", res)
+}
+
+// GetDelegationEvents - Jejak audit delegasi (admin)
+// @GET /elections/:id/delegations/events
+func (h *DelegationHandler) GetDelegationEvents(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.DelegationUsecase.GetDelegationEvents(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Delegation events retrieved successfully", res)
+}
+
+// GetMyDelegations - Kuasa yang diberikan dan dipegang pemilih yang login
+// @GET /elections/:id/delegations/me
+func (h *DelegationHandler) GetMyDelegations(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.DelegationUsecase.GetMyDelegations(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Delegations retrieved successfully", res)
+}
+
+// CreateDelegation - Kuasakan suara kepada pemilih DPT lain
+// @POST /elections/:id/delegations
+// Body: {proxy: string (email atau nomor anggota)}
+func (h *DelegationHandler) CreateDelegation(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CreateDelegationRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.DelegationUsecase.CreateDelegation(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Delegation created successfully", res)
+}
+
+// RevokeDelegation - Cabut delegasi yang belum dipakai
+// @DELETE /elections/:id/delegations/:delegation_id
+func (h *DelegationHandler) RevokeDelegation(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("delegation_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid delegation ID", err)
+	}
+
+	res, sysErr := h.DelegationUsecase.RevokeDelegation(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Delegation revoked successfully", res)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/delegation/usecase"
+
+type DelegationHandler struct {
+	DelegationUsecase usecase.IDelegationUsecase
+}
+
+func InitDelegationHandler(delegationUsecase usecase.IDelegationUsecase) *DelegationHandler {
+	return &DelegationHandler{
+		DelegationUsecase: delegationUsecase,
+	}
+}
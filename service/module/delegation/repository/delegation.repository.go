@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/entity"
+)
+
+const delegationColumns = `id, election_id, delegator_voter_roll_id, proxy_voter_roll_id, status, created_at, revoked_at`
+
+const delegationDetailQuery = `SELECT d.id, d.election_id, d.delegator_voter_roll_id, d.proxy_voter_roll_id, d.status, d.created_at, d.revoked_at,
+	          dv.name AS delegator_name, dv.email AS delegator_email, pv.name AS proxy_name, pv.email AS proxy_email
+	          FROM public.election_delegations d
+	          JOIN public.voter_rolls dv ON dv.id = d.delegator_voter_roll_id
+	          JOIN public.voter_rolls pv ON pv.id = d.proxy_voter_roll_id`
+
+// GetDelegations - Seluruh delegasi election termasuk yang sudah dicabut
+func (r *DelegationRepository) GetDelegations(ctx fiber.Ctx, electionID int) (res []entity.DelegationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := delegationDetailQuery + ` WHERE d.election_id = $1 ORDER BY d.id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil delegasi")
+		return
+	}
+	return
+}
+
+// GetDelegationForUpdate - Ambil delegasi sekaligus menguncinya sampai transaction selesai
+func (r *DelegationRepository) GetDelegationForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Delegation, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + delegationColumns + ` FROM public.election_delegations WHERE id = $1 AND election_id = $2 FOR UPDATE`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Delegasi tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil delegasi")
+	}
+	return
+}
+
+// GetDelegationByDelegator - Delegasi yang belum dicabut milik delegator
+func (r *DelegationRepository) GetDelegationByDelegator(ctx fiber.Ctx, electionID int, voterRollID int) (res entity.DelegationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := delegationDetailQuery + ` WHERE d.election_id = $1 AND d.delegator_voter_roll_id = $2 AND d.status <> $3`
+
+	model := db.Get(&res, query, electionID, voterRollID, entity.StatusRevoked)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Delegasi tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil delegasi")
+	}
+	return
+}
+
+// GetDelegationsByProxy - Delegasi yang belum dicabut yang dipegang proxy
+func (r *DelegationRepository) GetDelegationsByProxy(ctx fiber.Ctx, electionID int, voterRollID int) (res []entity.DelegationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := delegationDetailQuery + ` WHERE d.election_id = $1 AND d.proxy_voter_roll_id = $2 AND d.status <> $3 ORDER BY d.id`
+
+	model := db.Select(&res, query, electionID, voterRollID, entity.StatusRevoked)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil delegasi")
+		return
+	}
+	return
+}
+
+// CountHeldDelegations - Jumlah kuasa yang dipegang proxy, kuasa yang sudah dipakai tetap dihitung
+func (r *DelegationRepository) CountHeldDelegations(ctx fiber.Ctx, electionID int, voterRollID int) (total int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COUNT(*) FROM public.election_delegations WHERE election_id = $1 AND proxy_voter_roll_id = $2 AND status <> $3`
+
+	model := db.Get(&total, query, electionID, voterRollID, entity.StatusRevoked)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menghitung kuasa yang dipegang")
+		return
+	}
+	return
+}
+
+// LockVoterRolls - Kunci entri DPT (berurutan id supaya tidak deadlock) sampai transaction selesai,
+// sehingga pengecekan batas kuasa dan rantai delegasi tidak balapan
+func (r *DelegationRepository) LockVoterRolls(ctx fiber.Ctx, electionID int, voterRollIDs []int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	var ids []int
+	query := `SELECT id FROM public.voter_rolls WHERE election_id = $1 AND id = ANY($2) ORDER BY id FOR UPDATE`
+
+	model := db.Select(&ids, query, electionID, pq.Array(voterRollIDs))
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengunci DPT")
+		return
+	}
+	return
+}
+
+// HasVoted - Cek buku partisipasi apakah entri DPT sudah memberikan suara
+func (r *DelegationRepository) HasVoted(ctx fiber.Ctx, electionID int, voterRollID int) (voted bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (SELECT 1 FROM public.election_participations WHERE election_id = $1 AND voter_roll_id = $2)`
+
+	model := db.Get(&voted, query, electionID, voterRollID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil partisipasi")
+		return
+	}
+	return
+}
+
+// CreateDelegation - Simpan delegasi baru, unique index menolak delegator yang masih memiliki delegasi
+func (r *DelegationRepository) CreateDelegation(ctx fiber.Ctx, delegation entity.Delegation) (res entity.Delegation, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_delegations (election_id, delegator_voter_roll_id, proxy_voter_roll_id, status, created_at)
+	          VALUES ($1, $2, $3, $4, $5)
+	          RETURNING ` + delegationColumns
+
+	model := db.Get(&res, query, delegation.ElectionID, delegation.DelegatorVoterRollID, delegation.ProxyVoterRollID, entity.StatusActive, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Anda sudah menguasakan suara pada election ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan delegasi")
+	}
+	return
+}
+
+// UpdateDelegationStatus - Tandai delegasi dicabut beserta waktunya, atau sudah dipakai tanpa waktu
+// supaya pemakaian kuasa tidak bisa dicocokkan dengan urutan rantai suara
+func (r *DelegationRepository) UpdateDelegationStatus(ctx fiber.Ctx, id int, status string) (res entity.Delegation, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_delegations
+	          SET status = $1,
+	              revoked_at = CASE WHEN $1 = 'revoked' THEN $2 ELSE revoked_at END
+	          WHERE id = $3
+	          RETURNING ` + delegationColumns
+
+	model := db.Get(&res, query, status, helper.Now(), id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Delegasi tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengubah delegasi")
+	}
+	return
+}
+
+// CreateEvent - Tambahkan kejadian ke jejak audit delegasi
+func (r *DelegationRepository) CreateEvent(ctx fiber.Ctx, event entity.DelegationEvent) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_delegation_events (election_id, delegation_id, event, actor_user_id, created_at)
+	          VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := db.Exec(query, event.ElectionID, event.DelegationID, event.Event, event.ActorUserID, helper.Now())
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mencatat jejak audit delegasi")
+	}
+	return
+}
+
+// GetEvents - Jejak audit delegasi election sesuai urutan kejadian
+func (r *DelegationRepository) GetEvents(ctx fiber.Ctx, electionID int) (res []entity.DelegationEvent, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT e.id, e.election_id, e.delegation_id, d.delegator_voter_roll_id, d.proxy_voter_roll_id, e.event, e.actor_user_id, e.created_at
+	          FROM public.election_delegation_events e
+	          JOIN public.election_delegations d ON d.id = e.delegation_id
+	          WHERE e.election_id = $1
+	          ORDER BY e.id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil jejak audit delegasi")
+		return
+	}
+	return
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/entity"
+)
+
+type DelegationRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitDelegationRepository(mainDB *database.MainDB) IDelegationRepository {
+	return &DelegationRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *DelegationRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IDelegationRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetDelegations(ctx fiber.Ctx, electionID int) (res []entity.DelegationDetail, sysError syserror.SysError)
+	GetDelegationForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Delegation, sysError syserror.SysError)
+	GetDelegationByDelegator(ctx fiber.Ctx, electionID int, voterRollID int) (res entity.DelegationDetail, sysError syserror.SysError)
+	GetDelegationsByProxy(ctx fiber.Ctx, electionID int, voterRollID int) (res []entity.DelegationDetail, sysError syserror.SysError)
+	CountHeldDelegations(ctx fiber.Ctx, electionID int, voterRollID int) (total int, sysError syserror.SysError)
+	LockVoterRolls(ctx fiber.Ctx, electionID int, voterRollIDs []int) (sysError syserror.SysError)
+	HasVoted(ctx fiber.Ctx, electionID int, voterRollID int) (voted bool, sysError syserror.SysError)
+	CreateDelegation(ctx fiber.Ctx, delegation entity.Delegation) (res entity.Delegation, sysError syserror.SysError)
+	UpdateDelegationStatus(ctx fiber.Ctx, id int, status string) (res entity.Delegation, sysError syserror.SysError)
+	CreateEvent(ctx fiber.Ctx, event entity.DelegationEvent) (sysError syserror.SysError)
+	GetEvents(ctx fiber.Ctx, electionID int) (res []entity.DelegationEvent, sysError syserror.SysError)
+}
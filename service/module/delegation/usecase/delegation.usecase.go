@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	voterRollEntity "github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+// GetDelegations - Seluruh delegasi election (admin organization)
+func (u *DelegationUsecase) GetDelegations(ctx fiber.Ctx, electionID int) (res []entity.DelegationDetail, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.delegationRepo.GetDelegations(ctx, electionID)
+	return
+}
+
+// GetDelegationEvents - Jejak audit pembuatan, pencabutan dan pemakaian delegasi (admin organization).
+// Pemakaian delegasi baru muncul setelah election ditutup
+func (u *DelegationUsecase) GetDelegationEvents(ctx fiber.Ctx, electionID int) (res []entity.DelegationEvent, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.delegationRepo.GetEvents(ctx, electionID)
+	return
+}
+
+// GetMyDelegations - Kuasa yang diberikan dan dipegang pemilih yang login
+func (u *DelegationUsecase) GetMyDelegations(ctx fiber.Ctx, electionID int) (res dto.MyDelegationsResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	voter, sysError := u.getVoter(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.MyDelegationsResponse{
+		ElectionID: electionID,
+		ProxyLimit: election.ProxyLimit,
+	}
+
+	given, sysError := u.delegationRepo.GetDelegationByDelegator(ctx, electionID, voter.ID)
+	if sysError == nil {
+		res.Given = &given
+	} else if sysError.GetStatusCode() != fiber.StatusNotFound {
+		return
+	}
+
+	res.Held, sysError = u.delegationRepo.GetDelegationsByProxy(ctx, electionID, voter.ID)
+	return
+}
+
+// CreateDelegation - Kuasakan suara pemilih yang login kepada pemilih DPT lain.
+// Delegasi tidak boleh berantai: delegator tidak boleh memegang kuasa dan proxy tidak boleh menguasakan suaranya.
+// Jumlah kuasa yang dipegang proxy dibatasi proxy_limit election
+func (u *DelegationUsecase) CreateDelegation(ctx fiber.Ctx, electionID int, req dto.CreateDelegationRequest) (res entity.Delegation, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.getDelegableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.ProxyLimit == 0 {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election ini tidak mengizinkan delegasi suara")
+		return
+	}
+
+	delegator, sysError := u.getVoter(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	proxy, sysError := u.voterRollUse.GetVoterRollByIdentity(ctx, electionID, req.Proxy)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusNotFound, "Penerima kuasa tidak terdaftar dalam DPT election ini")
+		}
+		return
+	}
+	if proxy.ID == delegator.ID {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Tidak dapat menguasakan suara kepada diri sendiri")
+		return
+	}
+	if proxy.UserID == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Penerima kuasa harus memiliki akun untuk memberikan suara")
+		return
+	}
+
+	if sysError = u.delegationRepo.LockVoterRolls(ctx, electionID, []int{delegator.ID, proxy.ID}); sysError != nil {
+		return
+	}
+
+	voted, sysError := u.delegationRepo.HasVoted(ctx, electionID, delegator.ID)
+	if sysError != nil {
+		return
+	}
+	if voted {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Anda sudah memberikan suara pada election ini")
+		return
+	}
+
+	held, sysError := u.delegationRepo.CountHeldDelegations(ctx, electionID, delegator.ID)
+	if sysError != nil {
+		return
+	}
+	if held > 0 {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Pemilih yang memegang kuasa tidak dapat menguasakan suaranya")
+		return
+	}
+
+	if _, errProxy := u.delegationRepo.GetDelegationByDelegator(ctx, electionID, proxy.ID); errProxy == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Penerima kuasa sudah menguasakan suaranya kepada pemilih lain")
+		return
+	} else if errProxy.GetStatusCode() != fiber.StatusNotFound {
+		sysError = errProxy
+		return
+	}
+
+	held, sysError = u.delegationRepo.CountHeldDelegations(ctx, electionID, proxy.ID)
+	if sysError != nil {
+		return
+	}
+	if held >= election.ProxyLimit {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, fmt.Sprintf("Penerima kuasa sudah memegang batas maksimal %d kuasa", election.ProxyLimit))
+		return
+	}
+
+	res, sysError = u.delegationRepo.CreateDelegation(ctx, entity.Delegation{
+		ElectionID:           electionID,
+		DelegatorVoterRollID: delegator.ID,
+		ProxyVoterRollID:     proxy.ID,
+	})
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.recordEvent(ctx, res, entity.EventCreated)
+	return
+}
+
+// RevokeDelegation - Cabut delegasi oleh delegator atau proxy, hanya selama kuasa belum dipakai
+func (u *DelegationUsecase) RevokeDelegation(ctx fiber.Ctx, electionID int, id int) (res entity.Delegation, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.getDelegableElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	voter, sysError := u.getVoter(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	delegation, sysError := u.delegationRepo.GetDelegationForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if delegation.DelegatorVoterRollID != voter.ID && delegation.ProxyVoterRollID != voter.ID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Anda bukan pemberi maupun penerima kuasa delegasi ini")
+		return
+	}
+	if delegation.Status != entity.StatusActive {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Delegasi sudah dipakai atau dicabut")
+		return
+	}
+
+	res, sysError = u.delegationRepo.UpdateDelegationStatus(ctx, delegation.ID, entity.StatusRevoked)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.recordEvent(ctx, res, entity.EventRevoked)
+	return
+}
+
+// UseDelegation - Tandai kuasa dipakai proxy untuk memberikan suara atas nama delegator.
+// Harus dipanggil di dalam transaction yang sama dengan penyimpanan suara. Jejak audit pemakaian
+// baru dicatat saat election ditutup supaya urutannya tidak bisa dicocokkan dengan urutan rantai suara
+func (u *DelegationUsecase) UseDelegation(ctx fiber.Ctx, electionID int, proxyVoterRollID int, id int) (delegatorVoterRollID int, sysError syserror.SysError) {
+	delegation, sysError := u.delegationRepo.GetDelegationForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if delegation.ProxyVoterRollID != proxyVoterRollID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Anda bukan penerima kuasa delegasi ini")
+		return
+	}
+	if delegation.Status != entity.StatusActive {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Delegasi sudah dipakai atau dicabut")
+		return
+	}
+
+	delegation, sysError = u.delegationRepo.UpdateDelegationStatus(ctx, delegation.ID, entity.StatusUsed)
+	if sysError != nil {
+		return
+	}
+	delegatorVoterRollID = delegation.DelegatorVoterRollID
+	return
+}
+
+// EnsureNotDelegated - Tolak suara langsung dari pemilih yang suaranya masih dikuasakan.
+// Harus dipanggil di dalam transaction, entri DPT dikunci supaya tidak balapan dengan pembuatan delegasi
+func (u *DelegationUsecase) EnsureNotDelegated(ctx fiber.Ctx, electionID int, voterRollID int) (sysError syserror.SysError) {
+	if sysError = u.delegationRepo.LockVoterRolls(ctx, electionID, []int{voterRollID}); sysError != nil {
+		return
+	}
+
+	delegation, sysError := u.delegationRepo.GetDelegationByDelegator(ctx, electionID, voterRollID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = nil
+		}
+		return
+	}
+	if delegation.Status == entity.StatusActive {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Suara Anda sedang dikuasakan, cabut delegasi terlebih dahulu")
+	}
+	return
+}
+
+// getDelegableElection - Ambil election dengan share lock, delegasi hanya bisa diubah sebelum election ditutup
+func (u *DelegationUsecase) getDelegableElection(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetElectionByIDForShare(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status == electionEntity.StatusClosed || election.Status == electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Delegasi hanya dapat diubah sebelum election ditutup")
+	}
+	return
+}
+
+// getVoter - Entri DPT milik user yang login
+func (u *DelegationUsecase) getVoter(ctx fiber.Ctx, electionID int) (voter voterRollEntity.VoterRoll, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	voter, sysError = u.voterRollUse.GetVoterRollByUserID(ctx, electionID, userID)
+	if sysError != nil && sysError.GetStatusCode() == fiber.StatusNotFound {
+		sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Anda tidak terdaftar dalam DPT election ini")
+	}
+	return
+}
+
+// recordEvent - Catat kejadian delegasi beserta user yang melakukannya
+func (u *DelegationUsecase) recordEvent(ctx fiber.Ctx, delegation entity.Delegation, event string) syserror.SysError {
+	var actorUserID *int
+	if userID, err := middleware.GetUserID(ctx); err == nil {
+		actorUserID = &userID
+	}
+
+	return u.delegationRepo.CreateEvent(ctx, entity.DelegationEvent{
+		ElectionID:   delegation.ElectionID,
+		DelegationID: delegation.ID,
+		Event:        event,
+		ActorUserID:  actorUserID,
+	})
+}
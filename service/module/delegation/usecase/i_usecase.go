@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/repository"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	voterRollUsecase "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+)
+
+type DelegationUsecase struct {
+	delegationRepo repository.IDelegationRepository
+	electionUse    electionUsecase.IElectionUsecase
+	voterRollUse   voterRollUsecase.IVoterRollUsecase
+	mainDB         *dbpostgres.MainDB
+}
+
+func InitDelegationUsecase(delegationRepo repository.IDelegationRepository, electionUse electionUsecase.IElectionUsecase, voterRollUse voterRollUsecase.IVoterRollUsecase, mainDB *dbpostgres.MainDB) IDelegationUsecase {
+	return &DelegationUsecase{
+		delegationRepo: delegationRepo,
+		electionUse:    electionUse,
+		voterRollUse:   voterRollUse,
+		mainDB:         mainDB,
+	}
+}
+
+type IDelegationUsecase interface {
+	GetDelegations(ctx fiber.Ctx, electionID int) (res []entity.DelegationDetail, sysError syserror.SysError)
+	GetDelegationEvents(ctx fiber.Ctx, electionID int) (res []entity.DelegationEvent, sysError syserror.SysError)
+	GetMyDelegations(ctx fiber.Ctx, electionID int) (res dto.MyDelegationsResponse, sysError syserror.SysError)
+	CreateDelegation(ctx fiber.Ctx, electionID int, req dto.CreateDelegationRequest) (res entity.Delegation, sysError syserror.SysError)
+	RevokeDelegation(ctx fiber.Ctx, electionID int, id int) (res entity.Delegation, sysError syserror.SysError)
+	UseDelegation(ctx fiber.Ctx, electionID int, proxyVoterRollID int, id int) (delegatorVoterRollID int, sysError syserror.SysError)
+	EnsureNotDelegated(ctx fiber.Ctx, electionID int, voterRollID int) (sysError syserror.SysError)
+}
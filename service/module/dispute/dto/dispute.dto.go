@@ -0,0 +1,17 @@
+package dto
+
+// CreateDisputeRequest - DTO pengajuan sanggahan, lampiran diunggah terpisah setelah sanggahan dibuat
+type CreateDisputeRequest struct {
+	Subject     string `json:"subject" validate:"required,max=255"`
+	Description string `json:"description" validate:"required,max=10000"`
+}
+
+// RespondDisputeRequest - Tanggapan panitia atau pelapor atas sanggahan yang masih terbuka
+type RespondDisputeRequest struct {
+	Message string `json:"message" validate:"required,max=5000"`
+}
+
+// ResolveDisputeRequest - Keputusan panitia wajib diisi dan dikirim ke pelapor
+type ResolveDisputeRequest struct {
+	Resolution string `json:"resolution" validate:"required,max=5000"`
+}
@@ -0,0 +1,76 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	StatusOpen      = "open"
+	StatusResolved  = "resolved"  // sanggahan diterima dan ditindaklanjuti panitia
+	StatusDismissed = "dismissed" // sanggahan ditolak panitia
+)
+
+const (
+	FilerVoter     = "voter"     // pemilih yang terdaftar di DPT
+	FilerCandidate = "candidate" // candidate hasil pendaftaran yang disetujui
+)
+
+// Dispute - Sanggahan pemilih atau candidate terhadap hasil election yang diajukan selama masa sanggahan.
+// Selama masih ada sanggahan terbuka election ditandai under_dispute dan berita acara tidak dapat diterbitkan
+type Dispute struct {
+	ID          int                `db:"id" json:"id"`
+	ElectionID  int                `db:"election_id" json:"election_id"`
+	FiledBy     int                `db:"filed_by" json:"filed_by"`
+	FilerRole   string             `db:"filer_role" json:"filer_role"`
+	Subject     string             `db:"subject" json:"subject"`
+	Description string             `db:"description" json:"description"`
+	Status      string             `db:"status" json:"status"`
+	Resolution  *string            `db:"resolution" json:"resolution"`
+	ResolvedBy  *int               `db:"resolved_by" json:"resolved_by"`
+	ResolvedAt  *helper.CustomTime `db:"resolved_at" json:"resolved_at"`
+	CreatedAt   helper.CustomTime  `db:"created_at" json:"created_at"`
+	UpdatedAt   helper.CustomTime  `db:"updated_at" json:"updated_at"`
+}
+
+func (Dispute) TableName() string {
+	return "election_disputes"
+}
+
+// DisputeDetail - Sanggahan beserta identitas pelapor, tanggapan dan daftar lampirannya
+type DisputeDetail struct {
+	Dispute
+	FilerName   string              `db:"filer_name" json:"filer_name"`
+	FilerEmail  string              `db:"filer_email" json:"filer_email"`
+	Responses   []DisputeResponse   `db:"-" json:"responses,omitempty"`
+	Attachments []DisputeAttachment `db:"-" json:"attachments,omitempty"`
+}
+
+// DisputeResponse - Tanggapan atas sanggahan, IsCommittee membedakan tanggapan panitia dari pelapor
+type DisputeResponse struct {
+	ID           int               `db:"id" json:"id"`
+	DisputeID    int               `db:"dispute_id" json:"dispute_id"`
+	AuthorUserID *int              `db:"author_user_id" json:"author_user_id"`
+	AuthorName   string            `db:"author_name" json:"author_name"`
+	IsCommittee  bool              `db:"is_committee" json:"is_committee"`
+	Message      string            `db:"message" json:"message"`
+	CreatedAt    helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (DisputeResponse) TableName() string {
+	return "dispute_responses"
+}
+
+// DisputeAttachment - Lampiran bukti sanggahan. Content hanya diisi saat lampiran diunduh
+type DisputeAttachment struct {
+	ID          int               `db:"id" json:"id"`
+	DisputeID   int               `db:"dispute_id" json:"dispute_id"`
+	FileName    string            `db:"file_name" json:"file_name"`
+	ContentType string            `db:"content_type" json:"content_type"`
+	Size        int               `db:"size" json:"size"`
+	SHA256      string            `db:"sha256" json:"sha256"`
+	Content     []byte            `db:"content" json:"-"`
+	UploadedBy  *int              `db:"uploaded_by" json:"uploaded_by"`
+	CreatedAt   helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (DisputeAttachment) TableName() string {
+	return "dispute_attachments"
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/dto"
+)
+
+// GetDisputes - Daftar sanggahan election, bisa difilter status
+// @GET /elections/:id/disputes?status=
+func (h *DisputeHandler) GetDisputes(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.DisputeUsecase.GetDisputes(ctx, electionID, ctx.Query("status"))
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Disputes retrieved successfully", res)
+}
+
+// GetMyDisputes - Sanggahan yang diajukan user yang login
+// @GET /elections/:id/disputes/me
+func (h *DisputeHandler) GetMyDisputes(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.DisputeUsecase.GetMyDisputes(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Disputes retrieved successfully", res)
+}
+
+// GetDisputeByID - Detail sanggahan beserta tanggapan dan daftar lampiran
+// @GET /elections/:id/disputes/:dispute_id
+func (h *DisputeHandler) GetDisputeByID(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+
+	res, sysErr := h.DisputeUsecase.GetDisputeByID(ctx, electionID, disputeID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Dispute retrieved successfully", res)
+}
+
+// FileDispute - Ajukan sanggahan terhadap hasil election
+// @POST /elections/:id/disputes
+// Body: {subject: string, description: string}
+func (h *DisputeHandler) FileDispute(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CreateDisputeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.DisputeUsecase.FileDispute(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Dispute filed successfully", res)
+}
+
+// RespondDispute - Tambah tanggapan pada sanggahan yang masih terbuka
+// @POST /elections/:id/disputes/:dispute_id/responses
+// Body: {message: string}
+func (h *DisputeHandler) RespondDispute(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+
+	var req dto.RespondDisputeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.DisputeUsecase.RespondDispute(ctx, electionID, disputeID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Response added successfully", res)
+}
+
+// ResolveDispute - Terima sanggahan dan catat tindak lanjutnya
+// @POST /elections/:id/disputes/:dispute_id/resolve
+// Body: {resolution: string}
+func (h *DisputeHandler) ResolveDispute(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+
+	var req dto.ResolveDisputeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.DisputeUsecase.ResolveDispute(ctx, electionID, disputeID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Dispute resolved successfully", res)
+}
+
+// DismissDispute - Tolak sanggahan dengan alasan
+// @POST /elections/:id/disputes/:dispute_id/dismiss
+// Body: {resolution: string}
+func (h *DisputeHandler) DismissDispute(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+
+	var req dto.ResolveDisputeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.DisputeUsecase.DismissDispute(ctx, electionID, disputeID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Dispute dismissed successfully", res)
+}
+
+// UploadAttachment - Unggah lampiran bukti sanggahan
+// @POST /elections/:id/disputes/:dispute_id/attachments
+// Form: file (multipart, PDF/JPEG/PNG)
+func (h *DisputeHandler) UploadAttachment(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Lampiran wajib diunggah", err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Gagal membaca lampiran", err)
+	}
+	defer file.Close()
+
+	res, sysErr := h.DisputeUsecase.UploadAttachment(ctx, electionID, disputeID, fileHeader.Filename, file)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Attachment uploaded successfully", res)
+}
+
+// DownloadAttachment - Unduh isi lampiran sanggahan
+// @GET /elections/:id/disputes/:dispute_id/attachments/:attachment_id
+func (h *DisputeHandler) DownloadAttachment(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	disputeID, err := strconv.Atoi(ctx.Params("dispute_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid dispute ID", err)
+	}
+	attachmentID, err := strconv.Atoi(ctx.Params("attachment_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid attachment ID", err)
+	}
+
+	res, sysErr := h.DisputeUsecase.GetAttachment(ctx, electionID, disputeID, attachmentID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	ctx.Set(fiber.HeaderContentType, res.ContentType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", res.FileName))
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	return ctx.Status(fiber.StatusOK).Send(res.Content)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/dispute/usecase"
+
+type DisputeHandler struct {
+	DisputeUsecase usecase.IDisputeUsecase
+}
+
+func InitDisputeHandler(disputeUsecase usecase.IDisputeUsecase) *DisputeHandler {
+	return &DisputeHandler{
+		DisputeUsecase: disputeUsecase,
+	}
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/entity"
+)
+
+const disputeColumns = `id, election_id, filed_by, filer_role, subject, description, status, resolution, resolved_by, resolved_at,
+	created_at, updated_at`
+
+const disputeDetailColumns = `d.id, d.election_id, d.filed_by, d.filer_role, d.subject, d.description, d.status, d.resolution,
+	d.resolved_by, d.resolved_at, d.created_at, d.updated_at, u.name AS filer_name, u.email AS filer_email`
+
+// attachmentColumns - tanpa content, isi file hanya diambil lewat GetAttachment
+const attachmentColumns = `id, dispute_id, file_name, content_type, size, sha256, uploaded_by, created_at`
+
+// GetDisputes - Semua sanggahan election, status kosong berarti semua status
+func (r *DisputeRepository) GetDisputes(ctx fiber.Ctx, electionID int, status string) (res []entity.DisputeDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + disputeDetailColumns + `
+	          FROM public.election_disputes d
+	          JOIN public.users u ON u.id = d.filed_by
+	          WHERE d.election_id = $1 AND ($2 = '' OR d.status = $2)
+	          ORDER BY d.id`
+
+	model := db.Select(&res, query, electionID, status)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil sanggahan")
+		return
+	}
+	return
+}
+
+// GetDisputesByUser - Sanggahan yang diajukan user pada election
+func (r *DisputeRepository) GetDisputesByUser(ctx fiber.Ctx, electionID int, userID int) (res []entity.DisputeDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + disputeDetailColumns + `
+	          FROM public.election_disputes d
+	          JOIN public.users u ON u.id = d.filed_by
+	          WHERE d.election_id = $1 AND d.filed_by = $2
+	          ORDER BY d.id`
+
+	model := db.Select(&res, query, electionID, userID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil sanggahan")
+		return
+	}
+	return
+}
+
+func (r *DisputeRepository) GetDisputeByID(ctx fiber.Ctx, electionID int, id int) (res entity.DisputeDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + disputeDetailColumns + `
+	          FROM public.election_disputes d
+	          JOIN public.users u ON u.id = d.filed_by
+	          WHERE d.id = $1 AND d.election_id = $2`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Sanggahan tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil sanggahan")
+	}
+	return
+}
+
+// GetDisputeForUpdate - Ambil sanggahan sambil mengunci row-nya sampai transaction selesai
+func (r *DisputeRepository) GetDisputeForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Dispute, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + disputeColumns + ` FROM public.election_disputes WHERE id = $1 AND election_id = $2 FOR UPDATE`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Sanggahan tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil sanggahan")
+	}
+	return
+}
+
+func (r *DisputeRepository) CreateDispute(ctx fiber.Ctx, dispute entity.Dispute) (res entity.Dispute, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_disputes (election_id, filed_by, filer_role, subject, description, status, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+	          RETURNING ` + disputeColumns
+
+	model := db.Get(&res, query, dispute.ElectionID, dispute.FiledBy, dispute.FilerRole, dispute.Subject, dispute.Description,
+		entity.StatusOpen, helper.Now())
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat sanggahan")
+	}
+	return
+}
+
+// UpdateDisputeStatus - Simpan keputusan panitia atas sanggahan
+func (r *DisputeRepository) UpdateDisputeStatus(ctx fiber.Ctx, dispute entity.Dispute) (res entity.Dispute, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_disputes
+	          SET status = $1, resolution = $2, resolved_by = $3, resolved_at = $4, updated_at = $4
+	          WHERE id = $5
+	          RETURNING ` + disputeColumns
+
+	model := db.Get(&res, query, dispute.Status, dispute.Resolution, dispute.ResolvedBy, dispute.ResolvedAt, dispute.ID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Sanggahan tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate sanggahan")
+	}
+	return
+}
+
+// CountOpenDisputes - Jumlah sanggahan election yang belum diputuskan panitia
+func (r *DisputeRepository) CountOpenDisputes(ctx fiber.Ctx, electionID int) (count int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COUNT(*) FROM public.election_disputes WHERE election_id = $1 AND status = $2`
+
+	model := db.Get(&count, query, electionID, entity.StatusOpen)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menghitung sanggahan")
+	}
+	return
+}
+
+func (r *DisputeRepository) GetResponses(ctx fiber.Ctx, disputeID int) (res []entity.DisputeResponse, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT r.id, r.dispute_id, r.author_user_id, COALESCE(u.name, '') AS author_name, r.is_committee, r.message, r.created_at
+	          FROM public.dispute_responses r
+	          LEFT JOIN public.users u ON u.id = r.author_user_id
+	          WHERE r.dispute_id = $1
+	          ORDER BY r.id`
+
+	model := db.Select(&res, query, disputeID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil tanggapan sanggahan")
+		return
+	}
+	return
+}
+
+func (r *DisputeRepository) CreateResponse(ctx fiber.Ctx, response entity.DisputeResponse) (res entity.DisputeResponse, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH response AS (
+	              INSERT INTO public.dispute_responses (dispute_id, author_user_id, is_committee, message, created_at)
+	              VALUES ($1, $2, $3, $4, $5)
+	              RETURNING id, dispute_id, author_user_id, is_committee, message, created_at
+	          )
+	          SELECT r.id, r.dispute_id, r.author_user_id, COALESCE(u.name, '') AS author_name, r.is_committee, r.message, r.created_at
+	          FROM response r
+	          LEFT JOIN public.users u ON u.id = r.author_user_id`
+
+	model := db.Get(&res, query, response.DisputeID, response.AuthorUserID, response.IsCommittee, response.Message, helper.Now())
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan tanggapan sanggahan")
+	}
+	return
+}
+
+func (r *DisputeRepository) GetAttachments(ctx fiber.Ctx, disputeID int) (res []entity.DisputeAttachment, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + attachmentColumns + ` FROM public.dispute_attachments WHERE dispute_id = $1 ORDER BY id`
+
+	model := db.Select(&res, query, disputeID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil lampiran sanggahan")
+		return
+	}
+	return
+}
+
+// GetAttachment - Satu lampiran beserta isi file-nya
+func (r *DisputeRepository) GetAttachment(ctx fiber.Ctx, disputeID int, id int) (res entity.DisputeAttachment, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + attachmentColumns + `, content FROM public.dispute_attachments WHERE id = $1 AND dispute_id = $2`
+
+	model := db.Get(&res, query, id, disputeID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Lampiran tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil lampiran sanggahan")
+	}
+	return
+}
+
+func (r *DisputeRepository) CountAttachments(ctx fiber.Ctx, disputeID int) (count int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COUNT(*) FROM public.dispute_attachments WHERE dispute_id = $1`
+
+	model := db.Get(&count, query, disputeID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menghitung lampiran sanggahan")
+	}
+	return
+}
+
+func (r *DisputeRepository) CreateAttachment(ctx fiber.Ctx, attachment entity.DisputeAttachment) (res entity.DisputeAttachment, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.dispute_attachments (dispute_id, file_name, content_type, size, sha256, content, uploaded_by, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          RETURNING ` + attachmentColumns
+
+	model := db.Get(&res, query, attachment.DisputeID, attachment.FileName, attachment.ContentType, attachment.Size,
+		attachment.SHA256, attachment.Content, attachment.UploadedBy, helper.Now())
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan lampiran sanggahan")
+	}
+	return
+}
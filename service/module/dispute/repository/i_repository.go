@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/entity"
+)
+
+type DisputeRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitDisputeRepository(mainDB *database.MainDB) IDisputeRepository {
+	return &DisputeRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *DisputeRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IDisputeRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetDisputes(ctx fiber.Ctx, electionID int, status string) (res []entity.DisputeDetail, sysError syserror.SysError)
+	GetDisputesByUser(ctx fiber.Ctx, electionID int, userID int) (res []entity.DisputeDetail, sysError syserror.SysError)
+	GetDisputeByID(ctx fiber.Ctx, electionID int, id int) (res entity.DisputeDetail, sysError syserror.SysError)
+	GetDisputeForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Dispute, sysError syserror.SysError)
+	CreateDispute(ctx fiber.Ctx, dispute entity.Dispute) (res entity.Dispute, sysError syserror.SysError)
+	UpdateDisputeStatus(ctx fiber.Ctx, dispute entity.Dispute) (res entity.Dispute, sysError syserror.SysError)
+	CountOpenDisputes(ctx fiber.Ctx, electionID int) (count int, sysError syserror.SysError)
+
+	GetResponses(ctx fiber.Ctx, disputeID int) (res []entity.DisputeResponse, sysError syserror.SysError)
+	CreateResponse(ctx fiber.Ctx, response entity.DisputeResponse) (res entity.DisputeResponse, sysError syserror.SysError)
+
+	GetAttachments(ctx fiber.Ctx, disputeID int) (res []entity.DisputeAttachment, sysError syserror.SysError)
+	GetAttachment(ctx fiber.Ctx, disputeID int, id int) (res entity.DisputeAttachment, sysError syserror.SysError)
+	CountAttachments(ctx fiber.Ctx, disputeID int) (count int, sysError syserror.SysError)
+	CreateAttachment(ctx fiber.Ctx, attachment entity.DisputeAttachment) (res entity.DisputeAttachment, sysError syserror.SysError)
+}
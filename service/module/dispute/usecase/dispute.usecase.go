@@ -0,0 +1,418 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/entity"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	notificationEntity "github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+)
+
+const (
+	// maxAttachmentSize - batas ukuran satu lampiran, di bawah batas body request default Fiber (4 MB)
+	maxAttachmentSize = 2 << 20
+	// maxAttachments - batas jumlah lampiran per sanggahan
+	maxAttachments = 10
+)
+
+// allowedAttachmentTypes - jenis lampiran bukti yang diterima, dideteksi dari isi file
+var allowedAttachmentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// GetDisputes - Daftar sanggahan election untuk ditangani panitia (admin organization)
+func (u *DisputeUsecase) GetDisputes(ctx fiber.Ctx, electionID int, status string) (res []entity.DisputeDetail, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.disputeRepo.GetDisputes(ctx, electionID, status)
+	return
+}
+
+// GetMyDisputes - Sanggahan yang diajukan user yang login
+func (u *DisputeUsecase) GetMyDisputes(ctx fiber.Ctx, electionID int) (res []entity.DisputeDetail, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.disputeRepo.GetDisputesByUser(ctx, electionID, userID)
+	return
+}
+
+// GetDisputeByID - Detail sanggahan beserta tanggapan dan lampiran (pelapor atau admin organization)
+func (u *DisputeUsecase) GetDisputeByID(ctx fiber.Ctx, electionID int, id int) (res entity.DisputeDetail, sysError syserror.SysError) {
+	res, sysError = u.getAccessibleDispute(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	if res.Responses, sysError = u.disputeRepo.GetResponses(ctx, id); sysError != nil {
+		return
+	}
+	res.Attachments, sysError = u.disputeRepo.GetAttachments(ctx, id)
+	return
+}
+
+// FileDispute - Pemilih (DPT) atau candidate mengajukan sanggahan selama masa sanggahan setelah hasil dipublikasikan.
+// Election langsung ditandai under_dispute sehingga berita acara tertahan sampai semua sanggahan diputuskan
+func (u *DisputeUsecase) FileDispute(ctx fiber.Ctx, electionID int, req dto.CreateDisputeRequest) (res entity.Dispute, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	subject := strings.TrimSpace(req.Subject)
+	description := strings.TrimSpace(req.Description)
+	if subject == "" || description == "" {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Subject dan uraian sanggahan wajib diisi")
+		return
+	}
+
+	// row election dikunci supaya penanda under_dispute tidak balapan dengan penyelesaian sanggahan lain
+	election, sysError := u.electionUse.GetElectionByIDForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	open, sysError := u.electionUse.IsDisputeWindowOpen(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if !open {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Masa sanggahan election sedang tidak dibuka")
+		return
+	}
+
+	filerRole, sysError := u.resolveFilerRole(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+
+	res, sysError = u.disputeRepo.CreateDispute(ctx, entity.Dispute{
+		ElectionID:  electionID,
+		FiledBy:     userID,
+		FilerRole:   filerRole,
+		Subject:     subject,
+		Description: description,
+	})
+	if sysError != nil {
+		return
+	}
+
+	if !election.UnderDispute {
+		sysError = u.electionUse.SetUnderDispute(ctx, electionID, true)
+	}
+	return
+}
+
+// RespondDispute - Tambah tanggapan pada sanggahan yang masih terbuka. Pelapor menanggapi sebagai pihak pelapor,
+// admin organization sebagai panitia dan tanggapannya dikirim sebagai notifikasi ke pelapor
+func (u *DisputeUsecase) RespondDispute(ctx fiber.Ctx, electionID int, id int, req dto.RespondDisputeRequest) (res entity.DisputeResponse, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Tanggapan tidak boleh kosong")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	dispute, sysError := u.disputeRepo.GetDisputeForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	var election electionEntity.Election
+	isCommittee := dispute.FiledBy != userID
+	if isCommittee {
+		if election, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+			return
+		}
+	}
+
+	if dispute.Status != entity.StatusOpen {
+		sysError = errAlreadyDecided()
+		return
+	}
+
+	res, sysError = u.disputeRepo.CreateResponse(ctx, entity.DisputeResponse{
+		DisputeID:    dispute.ID,
+		AuthorUserID: &userID,
+		IsCommittee:  isCommittee,
+		Message:      message,
+	})
+	if sysError != nil || !isCommittee {
+		return
+	}
+
+	sysError = u.notifyFiler(ctx, election, dispute, notificationEntity.TypeDisputeResponded,
+		"Sanggahan Anda ditanggapi panitia",
+		fmt.Sprintf("Panitia menanggapi sanggahan \"%s\" pada %s: %s", dispute.Subject, election.Title, message))
+	return
+}
+
+// ResolveDispute - Panitia menerima sanggahan dan mencatat tindak lanjutnya
+func (u *DisputeUsecase) ResolveDispute(ctx fiber.Ctx, electionID int, id int, req dto.ResolveDisputeRequest) (res entity.Dispute, sysError syserror.SysError) {
+	return u.decideDispute(ctx, electionID, id, entity.StatusResolved, req.Resolution)
+}
+
+// DismissDispute - Panitia menolak sanggahan dengan alasan
+func (u *DisputeUsecase) DismissDispute(ctx fiber.Ctx, electionID int, id int, req dto.ResolveDisputeRequest) (res entity.Dispute, sysError syserror.SysError) {
+	return u.decideDispute(ctx, electionID, id, entity.StatusDismissed, req.Resolution)
+}
+
+// UploadAttachment - Pelapor mengunggah lampiran bukti (PDF, JPEG atau PNG) selama sanggahan masih terbuka
+func (u *DisputeUsecase) UploadAttachment(ctx fiber.Ctx, electionID int, id int, fileName string, file io.Reader) (res entity.DisputeAttachment, sysError syserror.SysError) {
+	// file dibaca sebelum transaction dibuka supaya lock sanggahan tidak tertahan selama upload
+	content, contentType, sysError := readAttachment(file)
+	if sysError != nil {
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	dispute, sysError := u.disputeRepo.GetDisputeForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if dispute.FiledBy != userID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya pelapor yang dapat melampirkan bukti sanggahan")
+		return
+	}
+	if dispute.Status != entity.StatusOpen {
+		sysError = errAlreadyDecided()
+		return
+	}
+
+	count, sysError := u.disputeRepo.CountAttachments(ctx, dispute.ID)
+	if sysError != nil {
+		return
+	}
+	if count >= maxAttachments {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, fmt.Sprintf("Maksimal %d lampiran per sanggahan", maxAttachments))
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	res, sysError = u.disputeRepo.CreateAttachment(ctx, entity.DisputeAttachment{
+		DisputeID:   dispute.ID,
+		FileName:    helper.UploadFileName(fileName),
+		ContentType: contentType,
+		Size:        len(content),
+		SHA256:      hex.EncodeToString(sum[:]),
+		Content:     content,
+		UploadedBy:  &userID,
+	})
+	return
+}
+
+// GetAttachment - Unduh lampiran sanggahan (pelapor atau admin organization)
+func (u *DisputeUsecase) GetAttachment(ctx fiber.Ctx, electionID int, id int, attachmentID int) (res entity.DisputeAttachment, sysError syserror.SysError) {
+	if _, sysError = u.getAccessibleDispute(ctx, electionID, id); sysError != nil {
+		return
+	}
+
+	res, sysError = u.disputeRepo.GetAttachment(ctx, id, attachmentID)
+	return
+}
+
+// decideDispute - Simpan keputusan panitia, lepaskan penanda under_dispute jika tidak ada lagi sanggahan terbuka
+// dan kirim keputusan ke pelapor
+func (u *DisputeUsecase) decideDispute(ctx fiber.Ctx, electionID int, id int, status string, resolution string) (res entity.Dispute, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	resolution = strings.TrimSpace(resolution)
+	if resolution == "" {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Keputusan sanggahan wajib diisi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	dispute, sysError := u.disputeRepo.GetDisputeForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if dispute.Status != entity.StatusOpen {
+		sysError = errAlreadyDecided()
+		return
+	}
+
+	reviewerID, _ := middleware.GetUserID(ctx)
+	resolvedAt := helper.Now()
+	dispute.Status = status
+	dispute.Resolution = &resolution
+	dispute.ResolvedBy = &reviewerID
+	dispute.ResolvedAt = &resolvedAt
+	res, sysError = u.disputeRepo.UpdateDisputeStatus(ctx, dispute)
+	if sysError != nil {
+		return
+	}
+
+	remaining, sysError := u.disputeRepo.CountOpenDisputes(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if remaining == 0 && election.UnderDispute {
+		if sysError = u.electionUse.SetUnderDispute(ctx, electionID, false); sysError != nil {
+			return
+		}
+	}
+
+	title, verdict := "Sanggahan Anda diterima", "diterima"
+	if status == entity.StatusDismissed {
+		title, verdict = "Sanggahan Anda ditolak", "ditolak"
+	}
+	sysError = u.notifyFiler(ctx, election, res, notificationEntity.TypeDisputeResolved, title,
+		fmt.Sprintf("Sanggahan \"%s\" pada %s %s panitia: %s", res.Subject, election.Title, verdict, resolution))
+	return
+}
+
+// getAccessibleDispute - Sanggahan hanya bisa dilihat pelapor atau admin organization
+func (u *DisputeUsecase) getAccessibleDispute(ctx fiber.Ctx, electionID int, id int) (res entity.DisputeDetail, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.disputeRepo.GetDisputeByID(ctx, electionID, id)
+	if sysError != nil || res.FiledBy == userID {
+		return
+	}
+
+	_, sysError = u.electionUse.GetManagedElection(ctx, electionID)
+	return
+}
+
+// resolveFilerRole - Pelapor harus candidate hasil pendaftaran yang disetujui atau pemilih yang terdaftar di DPT
+func (u *DisputeUsecase) resolveFilerRole(ctx fiber.Ctx, electionID int, userID int) (role string, sysError syserror.SysError) {
+	isCandidate, sysError := u.nominationUse.IsApprovedCandidate(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+	if isCandidate {
+		return entity.FilerCandidate, nil
+	}
+
+	if _, sysError = u.voterRollUse.GetVoterRollByUserID(ctx, electionID, userID); sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusForbidden, "Hanya pemilih atau candidate election yang dapat mengajukan sanggahan")
+		}
+		return
+	}
+	return entity.FilerVoter, nil
+}
+
+// notifyFiler - Kirim notifikasi perkembangan sanggahan ke pelapor
+func (u *DisputeUsecase) notifyFiler(ctx fiber.Ctx, election electionEntity.Election, dispute entity.Dispute, notificationType, title, message string) syserror.SysError {
+	return u.notificationUse.Notify(ctx, notificationEntity.Notification{
+		UserID:      dispute.FiledBy,
+		Type:        notificationType,
+		Title:       title,
+		Message:     message,
+		ElectionID:  &election.ID,
+		ReferenceID: &dispute.ID,
+	})
+}
+
+// readAttachment - Baca lampiran unggahan dan terjemahkan kegagalannya ke error API
+func readAttachment(file io.Reader) (content []byte, contentType string, sysError syserror.SysError) {
+	content, contentType, err := helper.ReadUpload(file, maxAttachmentSize, allowedAttachmentTypes)
+	switch {
+	case errors.Is(err, helper.ErrUploadEmpty):
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Lampiran tidak boleh kosong")
+	case errors.Is(err, helper.ErrUploadTooLarge):
+		sysError = syserror.CreateError(err, fiber.StatusRequestEntityTooLarge, fmt.Sprintf("Ukuran lampiran maksimal %d MB", maxAttachmentSize>>20))
+	case errors.Is(err, helper.ErrUploadUnsupported):
+		sysError = syserror.CreateError(err, fiber.StatusUnsupportedMediaType, "Lampiran harus berupa PDF, JPEG atau PNG")
+	case err != nil:
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Gagal membaca lampiran")
+	}
+	return
+}
+
+func errAlreadyDecided() syserror.SysError {
+	return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Sanggahan sudah diputuskan panitia dan tidak dapat diubah")
+}
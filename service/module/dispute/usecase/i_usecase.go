@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/repository"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	nominationUsecase "github.com/madmuzz05/be-enyoblos/service/module/nomination/usecase"
+	notificationUsecase "github.com/madmuzz05/be-enyoblos/service/module/notification/usecase"
+	voterRollUsecase "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+)
+
+type DisputeUsecase struct {
+	disputeRepo     repository.IDisputeRepository
+	electionUse     electionUsecase.IElectionUsecase
+	voterRollUse    voterRollUsecase.IVoterRollUsecase
+	nominationUse   nominationUsecase.INominationUsecase
+	notificationUse notificationUsecase.INotificationUsecase
+	mainDB          *dbpostgres.MainDB
+}
+
+func InitDisputeUsecase(disputeRepo repository.IDisputeRepository, electionUse electionUsecase.IElectionUsecase, voterRollUse voterRollUsecase.IVoterRollUsecase, nominationUse nominationUsecase.INominationUsecase, notificationUse notificationUsecase.INotificationUsecase, mainDB *dbpostgres.MainDB) IDisputeUsecase {
+	return &DisputeUsecase{
+		disputeRepo:     disputeRepo,
+		electionUse:     electionUse,
+		voterRollUse:    voterRollUse,
+		nominationUse:   nominationUse,
+		notificationUse: notificationUse,
+		mainDB:          mainDB,
+	}
+}
+
+type IDisputeUsecase interface {
+	GetDisputes(ctx fiber.Ctx, electionID int, status string) (res []entity.DisputeDetail, sysError syserror.SysError)
+	GetMyDisputes(ctx fiber.Ctx, electionID int) (res []entity.DisputeDetail, sysError syserror.SysError)
+	GetDisputeByID(ctx fiber.Ctx, electionID int, id int) (res entity.DisputeDetail, sysError syserror.SysError)
+	FileDispute(ctx fiber.Ctx, electionID int, req dto.CreateDisputeRequest) (res entity.Dispute, sysError syserror.SysError)
+	RespondDispute(ctx fiber.Ctx, electionID int, id int, req dto.RespondDisputeRequest) (res entity.DisputeResponse, sysError syserror.SysError)
+	ResolveDispute(ctx fiber.Ctx, electionID int, id int, req dto.ResolveDisputeRequest) (res entity.Dispute, sysError syserror.SysError)
+	DismissDispute(ctx fiber.Ctx, electionID int, id int, req dto.ResolveDisputeRequest) (res entity.Dispute, sysError syserror.SysError)
+
+	UploadAttachment(ctx fiber.Ctx, electionID int, id int, fileName string, file io.Reader) (res entity.DisputeAttachment, sysError syserror.SysError)
+	GetAttachment(ctx fiber.Ctx, electionID int, id int, attachmentID int) (res entity.DisputeAttachment, sysError syserror.SysError)
+}
@@ -0,0 +1,41 @@
+package dto
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+// CreateElectionRequest - DTO untuk create election, type kosong berarti election candidate biasa
+type CreateElectionRequest struct {
+	OrganizationID    int                `json:"organization_id" validate:"required"`
+	Title             string             `json:"title" validate:"required,max=255"`
+	Type              string             `json:"type" validate:"omitempty,oneof=election referendum"`
+	Description       string             `json:"description"`
+	VotingMethod      string             `json:"voting_method" validate:"omitempty,oneof=plurality approval irv borda"`
+	ProxyLimit        int                `json:"proxy_limit" validate:"gte=0"`
+	AllowRevote       bool               `json:"allow_revote"`
+	CandidateOrder    string             `json:"candidate_order" validate:"omitempty,oneof=fixed alphabetical ballot_number random"` // kosong berarti ballot_number
+	StartAt           *helper.CustomTime `json:"start_at"`
+	EndAt             *helper.CustomTime `json:"end_at"`
+	NominationStartAt *helper.CustomTime `json:"nomination_start_at"` // masa pendaftaran candidate, harus berakhir sebelum start_at
+	NominationEndAt   *helper.CustomTime `json:"nomination_end_at"`
+	DisputeWindowDays *int               `json:"dispute_window_days" validate:"omitempty,gte=0,lte=90"` // kosong berarti 3 hari
+}
+
+// UpdateElectionRequest - DTO untuk update election (hanya saat draft)
+type UpdateElectionRequest struct {
+	Title             string             `json:"title" validate:"required,max=255"`
+	Description       string             `json:"description"`
+	VotingMethod      string             `json:"voting_method" validate:"omitempty,oneof=plurality approval irv borda"`
+	ProxyLimit        int                `json:"proxy_limit" validate:"gte=0"`
+	AllowRevote       bool               `json:"allow_revote"`
+	CandidateOrder    string             `json:"candidate_order" validate:"omitempty,oneof=fixed alphabetical ballot_number random"` // kosong berarti ballot_number
+	StartAt           *helper.CustomTime `json:"start_at"`
+	EndAt             *helper.CustomTime `json:"end_at"`
+	NominationStartAt *helper.CustomTime `json:"nomination_start_at"` // masa pendaftaran candidate, harus berakhir sebelum start_at
+	NominationEndAt   *helper.CustomTime `json:"nomination_end_at"`
+	DisputeWindowDays *int               `json:"dispute_window_days" validate:"omitempty,gte=0,lte=90"` // kosong berarti 3 hari
+}
+
+// ChangeElectionStatusRequest - DTO untuk perpindahan status election
+type ChangeElectionStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=draft scheduled open closed published"`
+	Note   string `json:"note"`
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+const (
+	StatusDraft     = "draft"
+	StatusScheduled = "scheduled"
+	StatusOpen      = "open"
+	StatusClosed    = "closed"
+	StatusPublished = "published"
+)
+
+const (
+	TypeElection   = "election"
+	TypeReferendum = "referendum" // contest berupa pertanyaan, bukan candidate
+)
+
+const (
+	CandidateOrderFixed        = "fixed"         // urutan candidate dibuat
+	CandidateOrderAlphabetical = "alphabetical"  // urut nama candidate
+	CandidateOrderBallotNumber = "ballot_number" // urut nomor urut candidate
+	CandidateOrderRandom       = "random"        // diacak per pemilih, tetap sama setiap kali surat suara dibuka
+)
+
+// DefaultDisputeWindowDays - lama masa sanggahan setelah hasil dipublikasikan jika tidak diatur
+const DefaultDisputeWindowDays = 3
+
+// statusTransitions - daftar status tujuan yang diizinkan dari setiap status
+var statusTransitions = map[string][]string{
+	StatusDraft:     {StatusScheduled},
+	StatusScheduled: {StatusDraft, StatusOpen},
+	StatusOpen:      {StatusClosed},
+	StatusClosed:    {StatusPublished},
+}
+
+type Election struct {
+	ID                int                `db:"id" json:"id"`
+	OrganizationID    int                `db:"organization_id" json:"organization_id"`
+	Title             string             `db:"title" json:"title"`
+	Description       string             `db:"description" json:"description"`
+	Status            string             `db:"status" json:"status"`
+	Type              string             `db:"type" json:"type"`
+	VotingMethod      string             `db:"voting_method" json:"voting_method"` // default untuk contest baru
+	ProxyLimit        int                `db:"proxy_limit" json:"proxy_limit"`     // 0 berarti delegasi tidak diizinkan
+	AllowRevote       bool               `db:"allow_revote" json:"allow_revote"`   // pemilih boleh mengganti suara, ballot terakhir yang dihitung
+	CandidateOrder    string             `db:"candidate_order" json:"candidate_order"`
+	StartAt           *helper.CustomTime `db:"start_at" json:"start_at"`
+	EndAt             *helper.CustomTime `db:"end_at" json:"end_at"`
+	NominationStartAt *helper.CustomTime `db:"nomination_start_at" json:"nomination_start_at"` // kosong berarti pendaftaran candidate ditutup
+	NominationEndAt   *helper.CustomTime `db:"nomination_end_at" json:"nomination_end_at"`
+	DisputeWindowDays int                `db:"dispute_window_days" json:"dispute_window_days"` // hari setelah published_at untuk mengajukan sanggahan
+	UnderDispute      bool               `db:"under_dispute" json:"under_dispute"`             // masih ada sanggahan terbuka, berita acara tertahan
+	CreatedBy         int                `db:"created_by" json:"created_by"`
+	CreatedAt         helper.CustomTime  `db:"created_at" json:"created_at"`
+	UpdatedAt         helper.CustomTime  `db:"updated_at" json:"updated_at"`
+	ScheduledAt       *helper.CustomTime `db:"scheduled_at" json:"scheduled_at"`
+	OpenedAt          *helper.CustomTime `db:"opened_at" json:"opened_at"`
+	ClosedAt          *helper.CustomTime `db:"closed_at" json:"closed_at"`
+	PublishedAt       *helper.CustomTime `db:"published_at" json:"published_at"`
+}
+
+func (Election) TableName() string {
+	return "elections"
+}
+
+// CanTransitionTo - Cek apakah perpindahan status election diizinkan
+func (e Election) CanTransitionTo(status string) bool {
+	return slices.Contains(statusTransitions[e.Status], status)
+}
+
+type ElectionStatusLog struct {
+	ID         int               `db:"id" json:"id"`
+	ElectionID int               `db:"election_id" json:"election_id"`
+	FromStatus string            `db:"from_status" json:"from_status"`
+	ToStatus   string            `db:"to_status" json:"to_status"`
+	ChangedBy  *int              `db:"changed_by" json:"changed_by"`
+	Note       string            `db:"note" json:"note"`
+	ChangedAt  helper.CustomTime `db:"changed_at" json:"changed_at"`
+}
+
+func (ElectionStatusLog) TableName() string {
+	return "election_status_logs"
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/dto"
+)
+
+// GetElections - Get all elections, bisa difilter organization_id dan status
+// @GET /elections?organization_id=&status=
+func (h *ElectionHandler) GetElections(ctx fiber.Ctx) error {
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	organizationID := fiber.Query[int](ctx, "organization_id")
+	status := ctx.Query("status")
+
+	elections, totalRecords, sysError := h.ElectionUsecase.GetElections(ctx, organizationID, status)
+	if sysError != nil {
+		return helper.SendErrorResponse(ctx, sysError.GetStatusCode(), sysError.GetMessage(), sysError.GetError())
+	}
+
+	return helper.SendPaginatedResponse(ctx, fiber.StatusOK, "Elections retrieved successfully",
+		pagination.Page, pagination.PageSize, totalRecords, elections)
+}
+
+// GetElectionByID - Get single election by ID
+// @GET /elections/:id
+func (h *ElectionHandler) GetElectionByID(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.ElectionUsecase.GetElectionByID(ctx, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election retrieved successfully", res)
+}
+
+// CreateElection - Create new election
+// @POST /elections
+func (h *ElectionHandler) CreateElection(ctx fiber.Ctx) error {
+	var req dto.CreateElectionRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.ElectionUsecase.CreateElection(ctx, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Election created successfully", res)
+}
+
+// UpdateElection - Update existing election
+// @PUT /elections/:id
+func (h *ElectionHandler) UpdateElection(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.UpdateElectionRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.ElectionUsecase.UpdateElection(ctx, id, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election updated successfully", res)
+}
+
+// DeleteElection - Delete election
+// @DELETE /elections/:id
+func (h *ElectionHandler) DeleteElection(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	sysErr := h.ElectionUsecase.DeleteElection(ctx, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election deleted successfully", nil)
+}
+
+// ChangeStatus - Pindahkan status election (schedule, open, close, publish)
+// @PATCH /elections/:id/status
+// Body: {status: string, note?: string}
+func (h *ElectionHandler) ChangeStatus(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.ChangeElectionStatusRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.ElectionUsecase.ChangeStatus(ctx, id, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election status updated successfully", res)
+}
+
+// GetStatusLogs - Riwayat perpindahan status election
+// @GET /elections/:id/status-logs
+func (h *ElectionHandler) GetStatusLogs(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.ElectionUsecase.GetStatusLogs(ctx, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election status logs retrieved successfully", res)
+}
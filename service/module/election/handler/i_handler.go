@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+
+type ElectionHandler struct {
+	ElectionUsecase usecase.IElectionUsecase
+}
+
+func InitElectionHandler(electionUsecase usecase.IElectionUsecase) *ElectionHandler {
+	return &ElectionHandler{
+		ElectionUsecase: electionUsecase,
+	}
+}
@@ -0,0 +1,418 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	contestEntity "github.com/madmuzz05/be-enyoblos/service/module/contest/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+const electionColumns = `id, organization_id, title, description, status, type, voting_method, proxy_limit, allow_revote, candidate_order, start_at, end_at,
+	nomination_start_at, nomination_end_at, dispute_window_days, under_dispute, created_by, created_at, updated_at, scheduled_at, opened_at, closed_at, published_at`
+
+func (r *ElectionRepository) GetElections(ctx fiber.Ctx, organizationID int, status string) (res []entity.Election, totalRecords int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	// Parse pagination
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	offset := helper.GetOffset(pagination.Page, pagination.PageSize)
+	sort := "ASC"
+	if strings.EqualFold(pagination.Sort, "DESC") {
+		sort = "DESC"
+	}
+
+	filter := ` WHERE ($1 = 0 OR organization_id = $1) AND ($2 = '' OR status = $2)`
+
+	// Get total records
+	countQuery := `SELECT COUNT(*) FROM public.elections` + filter
+	model := db.Get(&totalRecords, countQuery, organizationID, status)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil total records")
+		return
+	}
+
+	// Get paginated data
+	query := `SELECT ` + electionColumns + ` FROM public.elections` + filter +
+		` ORDER BY id ` + sort + ` LIMIT $3 OFFSET $4`
+	model = db.Select(&res, query, organizationID, status, pagination.PageSize, offset)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil elections")
+		return
+	} else if len(res) == 0 {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Tidak ada election")
+	}
+	return
+}
+
+func (r *ElectionRepository) GetElectionByID(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	return r.getElection(ctx, id, "")
+}
+
+// GetElectionByIDForUpdate - Ambil election sekaligus mengunci row-nya sampai transaction selesai
+func (r *ElectionRepository) GetElectionByIDForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	return r.getElection(ctx, id, " FOR UPDATE")
+}
+
+// GetElectionByIDForShare - Ambil election dengan shared lock, status tidak bisa berubah
+// sampai transaction selesai tetapi transaction lain tetap bisa membaca bersamaan
+func (r *ElectionRepository) GetElectionByIDForShare(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	return r.getElection(ctx, id, " FOR SHARE")
+}
+
+// IsNominationOpen - Cek apakah now berada di dalam masa pendaftaran candidate election
+func (r *ElectionRepository) IsNominationOpen(ctx fiber.Ctx, id int, now helper.CustomTime) (open bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.elections
+	              WHERE id = $1 AND nomination_start_at <= $2 AND nomination_end_at > $2
+	          )`
+
+	model := db.Get(&open, query, id, now)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil masa pendaftaran candidate")
+	}
+	return
+}
+
+// IsDisputeWindowOpen - Cek apakah now masih berada di dalam masa sanggahan election yang sudah dipublikasikan
+func (r *ElectionRepository) IsDisputeWindowOpen(ctx fiber.Ctx, id int, now helper.CustomTime) (open bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.elections
+	              WHERE id = $1 AND status = 'published' AND published_at + make_interval(days => dispute_window_days) > $2
+	          )`
+
+	model := db.Get(&open, query, id, now)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil masa sanggahan")
+	}
+	return
+}
+
+// UpdateUnderDispute - Tandai atau lepaskan status sengketa election
+func (r *ElectionRepository) UpdateUnderDispute(ctx fiber.Ctx, id int, underDispute bool) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.elections SET under_dispute = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := db.Exec(query, underDispute, helper.Now(), id)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengupdate status sengketa election")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	}
+	return
+}
+
+// dueCondition - election terjadwal yang sudah waktunya dibuka atau election terbuka yang sudah waktunya ditutup
+const dueCondition = `((status = 'scheduled' AND start_at <= $1) OR (status = 'open' AND end_at <= $1))`
+
+// GetDueElections - Election yang status-nya perlu dipindahkan sesuai jadwal start_at / end_at
+func (r *ElectionRepository) GetDueElections(ctx fiber.Ctx, now helper.CustomTime) (res []entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+	query := `SELECT ` + electionColumns + ` FROM public.elections WHERE ` + dueCondition + ` ORDER BY id`
+
+	model := db.Select(&res, query, now)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil election terjadwal")
+		return
+	}
+	return
+}
+
+// GetDueElectionForUpdate - Kunci election hanya jika masih perlu dipindahkan sesuai jadwal,
+// not found berarti sudah dipindahkan oleh proses lain atau jadwalnya berubah
+func (r *ElectionRepository) GetDueElectionForUpdate(ctx fiber.Ctx, id int, now helper.CustomTime) (res entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+	query := `SELECT ` + electionColumns + ` FROM public.elections WHERE id = $2 AND ` + dueCondition + ` FOR UPDATE`
+
+	model := db.Get(&res, query, now, id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak perlu dipindahkan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil election")
+	}
+	return
+}
+
+func (r *ElectionRepository) getElection(ctx fiber.Ctx, id int, lock string) (res entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+	query := `SELECT ` + electionColumns + ` FROM public.elections WHERE id = $1` + lock
+
+	model := db.Get(&res, query, id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil election")
+	}
+	return
+}
+
+// CreateElection - Create new election dengan status draft beserta satu contest default
+// (nama election, 1 kursi, metode election) supaya election satu jabatan langsung bisa dipakai
+func (r *ElectionRepository) CreateElection(ctx fiber.Ctx, election entity.Election) (res entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	// referendum dimulai dengan satu pertanyaan ya/tidak/abstain, election biasa dengan satu contest candidate
+	kind := contestEntity.KindCandidate
+	var answerType *string
+	var names, answers []string
+	if election.Type == entity.TypeReferendum {
+		kind = contestEntity.KindQuestion
+		yesNoAbstain := contestEntity.AnswerTypeYesNoAbstain
+		answerType = &yesNoAbstain
+		for _, option := range contestEntity.FixedOptions(yesNoAbstain) {
+			names = append(names, option.Name)
+			answers = append(answers, option.Answer)
+		}
+	}
+
+	query := `WITH election AS (
+	              INSERT INTO public.elections (organization_id, title, description, status, type, voting_method, start_at, end_at, created_by, created_at, updated_at, proxy_limit, allow_revote,
+	                  nomination_start_at, nomination_end_at, dispute_window_days, candidate_order)
+	              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $15, $16, $17, $18, $19, $20)
+	              RETURNING ` + electionColumns + `
+	          ), contest AS (
+	              INSERT INTO public.contests (election_id, name, seats, voting_method, position, kind, answer_type, created_at, updated_at)
+	              SELECT id, title, 1, CASE WHEN $11::text = 'question' THEN 'plurality' ELSE voting_method END, 1, $11, $12, created_at, created_at
+	              FROM election
+	              RETURNING id, election_id, created_at
+	          ), options AS (
+	              INSERT INTO public.candidates (election_id, contest_id, ballot_number, name, answer, created_at, updated_at)
+	              SELECT c.election_id, c.id, o.ordinality, o.name, o.answer, c.created_at, c.created_at
+	              FROM contest c, UNNEST($13::text[], $14::text[]) WITH ORDINALITY AS o(name, answer, ordinality)
+	          )
+	          SELECT ` + electionColumns + ` FROM election`
+
+	model := db.Get(&res, query, election.OrganizationID, election.Title, election.Description, entity.StatusDraft, election.Type,
+		election.VotingMethod, election.StartAt, election.EndAt, election.CreatedBy, helper.Now(),
+		kind, answerType, pq.Array(names), pq.Array(answers), election.ProxyLimit, election.AllowRevote,
+		election.NominationStartAt, election.NominationEndAt, election.DisputeWindowDays, election.CandidateOrder)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat election")
+		return
+	}
+	return
+}
+
+// UpdateElection - Update data umum election
+func (r *ElectionRepository) UpdateElection(ctx fiber.Ctx, id int, election entity.Election) (res entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.elections
+	          SET title = $1, description = $2, voting_method = $3, start_at = $4, end_at = $5, updated_at = $6, proxy_limit = $8, allow_revote = $9,
+	              nomination_start_at = $10, nomination_end_at = $11, dispute_window_days = $12, candidate_order = $13
+	          WHERE id = $7
+	          RETURNING ` + electionColumns
+
+	model := db.Get(&res, query, election.Title, election.Description, election.VotingMethod, election.StartAt, election.EndAt, helper.Now(), id, election.ProxyLimit, election.AllowRevote,
+		election.NominationStartAt, election.NominationEndAt, election.DisputeWindowDays, election.CandidateOrder)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate election")
+	}
+	return
+}
+
+// DeleteElection - Delete election
+func (r *ElectionRepository) DeleteElection(ctx fiber.Ctx, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.elections WHERE id = $1`
+
+	result, err := db.Exec(query, id)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus election")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	}
+
+	return
+}
+
+// UpdateElectionStatus - Ubah status sekaligus mencatat waktu transisi pada kolom yang sesuai
+func (r *ElectionRepository) UpdateElectionStatus(ctx fiber.Ctx, id int, status string, changedAt helper.CustomTime) (res entity.Election, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.elections
+	          SET status = $1,
+	              updated_at = $2,
+	              scheduled_at = CASE WHEN $1 = 'scheduled' THEN $2 WHEN $1 = 'draft' THEN NULL ELSE scheduled_at END,
+	              opened_at = CASE WHEN $1 = 'open' THEN $2 ELSE opened_at END,
+	              closed_at = CASE WHEN $1 = 'closed' THEN $2 ELSE closed_at END,
+	              published_at = CASE WHEN $1 = 'published' THEN $2 ELSE published_at END
+	          WHERE id = $3
+	          RETURNING ` + electionColumns
+
+	model := db.Get(&res, query, status, changedAt, id)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengubah status election")
+	}
+	return
+}
+
+// RevokeVotingCodes - Batalkan kode voting yang belum terpakai, dipanggil saat election ditutup
+func (r *ElectionRepository) RevokeVotingCodes(ctx fiber.Ctx, electionID int, revokedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.voting_codes SET revoked_at = $1
+	          WHERE election_id = $2 AND NOT used AND revoked_at IS NULL`
+
+	if _, err := db.Exec(query, revokedAt, electionID); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membatalkan kode voting")
+	}
+	return
+}
+
+// ClearRevoteTags - Hapus revote tag ballot, dipanggil saat election ditutup karena pemungutan ulang tidak
+// mungkin lagi dan tag tidak perlu disimpan lebih lama dari yang dibutuhkan
+func (r *ElectionRepository) ClearRevoteTags(ctx fiber.Ctx, electionID int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.ballots SET revote_tag = NULL WHERE election_id = $1 AND revote_tag IS NOT NULL`
+
+	if _, err := db.Exec(query, electionID); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus revote tag ballot")
+	}
+	return
+}
+
+// RecordUsedDelegationEvents - Catat pemakaian delegasi ke jejak audit sekaligus saat election ditutup,
+// berurutan id delegasi dengan waktu penutupan sehingga tidak mengikuti urutan suara disimpan
+func (r *ElectionRepository) RecordUsedDelegationEvents(ctx fiber.Ctx, electionID int, closedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_delegation_events (election_id, delegation_id, event, actor_user_id, created_at)
+	          SELECT d.election_id, d.id, 'used', v.user_id, $2
+	          FROM public.election_delegations d
+	          JOIN public.voter_rolls v ON v.id = d.proxy_voter_roll_id
+	          WHERE d.election_id = $1 AND d.status = 'used'
+	          ORDER BY d.id`
+
+	if _, err := db.Exec(query, electionID, closedAt); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mencatat jejak audit delegasi")
+	}
+	return
+}
+
+// HasPendingKeyCeremony - Cek apakah key ceremony election sudah dimulai tetapi belum semua trustee mengirim kontribusi
+func (r *ElectionRepository) HasPendingKeyCeremony(ctx fiber.Ctx, electionID int) (pending bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.election_keys WHERE election_id = $1 AND completed_at IS NULL
+	          )`
+
+	model := db.Get(&pending, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil status key ceremony")
+	}
+	return
+}
+
+// CreateStatusLog - Catat riwayat perpindahan status election
+func (r *ElectionRepository) CreateStatusLog(ctx fiber.Ctx, log entity.ElectionStatusLog) (res entity.ElectionStatusLog, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_status_logs (election_id, from_status, to_status, changed_by, note, changed_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)
+	          RETURNING id, election_id, from_status, to_status, changed_by, note, changed_at`
+
+	model := db.Get(&res, query, log.ElectionID, log.FromStatus, log.ToStatus, log.ChangedBy, log.Note, log.ChangedAt)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mencatat status election")
+		return
+	}
+	return
+}
+
+func (r *ElectionRepository) GetStatusLogs(ctx fiber.Ctx, electionID int) (res []entity.ElectionStatusLog, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT id, election_id, from_status, to_status, changed_by, note, changed_at
+	          FROM public.election_status_logs
+	          WHERE election_id = $1
+	          ORDER BY changed_at, id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil riwayat status election")
+		return
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+type ElectionRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitElectionRepository(mainDB *database.MainDB) IElectionRepository {
+	return &ElectionRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *ElectionRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IElectionRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetElections(ctx fiber.Ctx, organizationID int, status string) (res []entity.Election, totalRecords int64, sysError syserror.SysError)
+	GetElectionByID(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetElectionByIDForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetElectionByIDForShare(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	IsNominationOpen(ctx fiber.Ctx, id int, now helper.CustomTime) (open bool, sysError syserror.SysError)
+	IsDisputeWindowOpen(ctx fiber.Ctx, id int, now helper.CustomTime) (open bool, sysError syserror.SysError)
+	UpdateUnderDispute(ctx fiber.Ctx, id int, underDispute bool) (sysError syserror.SysError)
+	GetDueElections(ctx fiber.Ctx, now helper.CustomTime) (res []entity.Election, sysError syserror.SysError)
+	GetDueElectionForUpdate(ctx fiber.Ctx, id int, now helper.CustomTime) (res entity.Election, sysError syserror.SysError)
+	CreateElection(ctx fiber.Ctx, election entity.Election) (res entity.Election, sysError syserror.SysError)
+	UpdateElection(ctx fiber.Ctx, id int, election entity.Election) (res entity.Election, sysError syserror.SysError)
+	DeleteElection(ctx fiber.Ctx, id int) (sysError syserror.SysError)
+	UpdateElectionStatus(ctx fiber.Ctx, id int, status string, changedAt helper.CustomTime) (res entity.Election, sysError syserror.SysError)
+	RevokeVotingCodes(ctx fiber.Ctx, electionID int, revokedAt helper.CustomTime) (sysError syserror.SysError)
+	ClearRevoteTags(ctx fiber.Ctx, electionID int) (sysError syserror.SysError)
+	RecordUsedDelegationEvents(ctx fiber.Ctx, electionID int, closedAt helper.CustomTime) (sysError syserror.SysError)
+	HasPendingKeyCeremony(ctx fiber.Ctx, electionID int) (pending bool, sysError syserror.SysError)
+	CreateStatusLog(ctx fiber.Ctx, log entity.ElectionStatusLog) (res entity.ElectionStatusLog, sysError syserror.SysError)
+	GetStatusLogs(ctx fiber.Ctx, electionID int) (res []entity.ElectionStatusLog, sysError syserror.SysError)
+}
@@ -0,0 +1,506 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/package/tally"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+)
+
+func (u *ElectionUsecase) GetElections(ctx fiber.Ctx, organizationID int, status string) (res []entity.Election, totalRecords int64, sysError syserror.SysError) {
+	res, totalRecords, sysError = u.electionRepo.GetElections(ctx, organizationID, status)
+	return
+}
+
+func (u *ElectionUsecase) GetElectionByID(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByID(ctx, id)
+	return
+}
+
+// GetElectionByIDForShare - Ambil election dan tahan statusnya sampai transaction selesai
+// Dipakai saat memberikan suara supaya election tidak ditutup di tengah proses
+func (u *ElectionUsecase) GetElectionByIDForShare(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByIDForShare(ctx, id)
+	return
+}
+
+// GetElectionByIDForUpdate - Ambil election sambil mengunci row-nya tanpa cek admin,
+// untuk proses anggota yang mengubah data election (misal sanggahan). Harus dipanggil di dalam transaction
+func (u *ElectionUsecase) GetElectionByIDForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByIDForUpdate(ctx, id)
+	return
+}
+
+// IsNominationOpen - Cek apakah masa pendaftaran candidate election sedang berlangsung
+func (u *ElectionUsecase) IsNominationOpen(ctx fiber.Ctx, id int) (open bool, sysError syserror.SysError) {
+	open, sysError = u.electionRepo.IsNominationOpen(ctx, id, helper.Now())
+	return
+}
+
+// IsDisputeWindowOpen - Cek apakah masa sanggahan setelah hasil dipublikasikan masih berlangsung
+func (u *ElectionUsecase) IsDisputeWindowOpen(ctx fiber.Ctx, id int) (open bool, sysError syserror.SysError) {
+	open, sysError = u.electionRepo.IsDisputeWindowOpen(ctx, id, helper.Now())
+	return
+}
+
+// SetUnderDispute - Tandai atau lepaskan status sengketa election, dipanggil module dispute
+// di dalam transaction yang sudah mengunci row election
+func (u *ElectionUsecase) SetUnderDispute(ctx fiber.Ctx, id int, underDispute bool) (sysError syserror.SysError) {
+	sysError = u.electionRepo.UpdateUnderDispute(ctx, id, underDispute)
+	return
+}
+
+// GetManagedElection - Ambil election dan pastikan user yang login adalah admin organization-nya
+// Dipakai module lain (candidate, ballot, dll) sebelum mengubah data election
+func (u *ElectionUsecase) GetManagedElection(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByID(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.ensureOrganizationAdmin(ctx, res.OrganizationID)
+	return
+}
+
+// GetObservableElection - Ambil election yang boleh dipantau user yang login:
+// admin organization-nya atau saksi (observer) yang ditunjuk untuk election tersebut.
+// isAdmin membedakan keduanya untuk data yang hanya boleh dilihat admin
+func (u *ElectionUsecase) GetObservableElection(ctx fiber.Ctx, id int) (res entity.Election, isAdmin bool, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByID(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	isAdmin, sysError = u.roleUse.IsOrganizationAdmin(ctx, userID, res.OrganizationID)
+	if sysError != nil || isAdmin {
+		return
+	}
+
+	isObserver, sysError := u.roleUse.IsElectionObserver(ctx, userID, id)
+	if sysError != nil {
+		return
+	}
+	if !isObserver {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya admin organization atau saksi election yang dapat memantau election")
+	}
+	return
+}
+
+// GetPollStationElection - Ambil election yang boleh dilayani user yang login di TPS:
+// admin organization-nya atau petugas TPS (poll_worker) yang ditunjuk untuk election tersebut
+func (u *ElectionUsecase) GetPollStationElection(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByID(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	isAdmin, sysError := u.roleUse.IsOrganizationAdmin(ctx, userID, res.OrganizationID)
+	if sysError != nil || isAdmin {
+		return
+	}
+
+	isPollWorker, sysError := u.roleUse.IsPollWorker(ctx, userID, id)
+	if sysError != nil {
+		return
+	}
+	if !isPollWorker {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya admin organization atau petugas TPS election yang dapat melayani kiosk")
+	}
+	return
+}
+
+// CreateElection - Create new election (status awal draft)
+func (u *ElectionUsecase) CreateElection(ctx fiber.Ctx, req dto.CreateElectionRequest) (res entity.Election, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	// check if organization exists
+	_, sysError = u.organizationUse.GetOrganizationByID(ctx, req.OrganizationID)
+	if sysError != nil {
+		return
+	}
+
+	if sysError = u.ensureOrganizationAdmin(ctx, req.OrganizationID); sysError != nil {
+		return
+	}
+
+	if sysError = validateSchedule(req.StartAt, req.EndAt); sysError != nil {
+		return
+	}
+
+	if sysError = validateNominationWindow(req.NominationStartAt, req.NominationEndAt, req.StartAt); sysError != nil {
+		return
+	}
+
+	electionType := req.Type
+	if electionType == "" {
+		electionType = entity.TypeElection
+	}
+
+	userID, _ := middleware.GetUserID(ctx)
+	res, sysError = u.electionRepo.CreateElection(ctx, entity.Election{
+		OrganizationID:    req.OrganizationID,
+		Title:             req.Title,
+		Description:       req.Description,
+		Type:              electionType,
+		VotingMethod:      votingMethodOrDefault(req.VotingMethod),
+		ProxyLimit:        req.ProxyLimit,
+		AllowRevote:       req.AllowRevote,
+		CandidateOrder:    candidateOrderOrDefault(req.CandidateOrder),
+		StartAt:           req.StartAt,
+		EndAt:             req.EndAt,
+		NominationStartAt: req.NominationStartAt,
+		NominationEndAt:   req.NominationEndAt,
+		DisputeWindowDays: disputeWindowOrDefault(req.DisputeWindowDays),
+		CreatedBy:         userID,
+	})
+	return
+}
+
+// UpdateElection - Update election, hanya diizinkan saat masih draft
+func (u *ElectionUsecase) UpdateElection(ctx fiber.Ctx, id int, req dto.UpdateElectionRequest) (res entity.Election, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.GetManagedElectionForUpdate(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	if election.Status != entity.StatusDraft {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election hanya dapat diubah saat berstatus draft")
+		return
+	}
+
+	if sysError = validateSchedule(req.StartAt, req.EndAt); sysError != nil {
+		return
+	}
+
+	if sysError = validateNominationWindow(req.NominationStartAt, req.NominationEndAt, req.StartAt); sysError != nil {
+		return
+	}
+
+	res, sysError = u.electionRepo.UpdateElection(ctx, id, entity.Election{
+		Title:             req.Title,
+		Description:       req.Description,
+		VotingMethod:      votingMethodOrDefault(req.VotingMethod),
+		ProxyLimit:        req.ProxyLimit,
+		AllowRevote:       req.AllowRevote,
+		CandidateOrder:    candidateOrderOrDefault(req.CandidateOrder),
+		StartAt:           req.StartAt,
+		EndAt:             req.EndAt,
+		NominationStartAt: req.NominationStartAt,
+		NominationEndAt:   req.NominationEndAt,
+		DisputeWindowDays: disputeWindowOrDefault(req.DisputeWindowDays),
+	})
+	return
+}
+
+// DeleteElection - Delete election, hanya diizinkan saat masih draft
+func (u *ElectionUsecase) DeleteElection(ctx fiber.Ctx, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.GetManagedElectionForUpdate(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	if election.Status != entity.StatusDraft {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election hanya dapat dihapus saat berstatus draft")
+		return
+	}
+
+	sysError = u.electionRepo.DeleteElection(ctx, id)
+	return
+}
+
+// ChangeStatus - Pindahkan status election sesuai alur draft -> scheduled -> open -> closed -> published
+func (u *ElectionUsecase) ChangeStatus(ctx fiber.Ctx, id int, req dto.ChangeElectionStatusRequest) (res entity.Election, sysError syserror.SysError) {
+	// didaftarkan sebelum transaction sehingga dijalankan setelah commit
+	defer func() {
+		if sysError == nil {
+			u.publishStatusChanged(id, res.Status)
+		}
+	}()
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.GetManagedElectionForUpdate(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	if !election.CanTransitionTo(req.Status) {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict,
+			fmt.Sprintf("Status election tidak dapat diubah dari %s ke %s", election.Status, req.Status))
+		return
+	}
+
+	if req.Status == entity.StatusScheduled {
+		if election.StartAt == nil || election.EndAt == nil {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "start_at dan end_at wajib diisi sebelum election dijadwalkan")
+			return
+		}
+	}
+
+	userID, _ := middleware.GetUserID(ctx)
+	res, _, sysError = u.applyStatus(ctx, election, req.Status, &userID, req.Note, helper.Now())
+	return
+}
+
+// GetDueElections - Election yang perlu dibuka atau ditutup sesuai jadwal, dipakai oleh scheduler
+func (u *ElectionUsecase) GetDueElections(ctx fiber.Ctx) (res []entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetDueElections(ctx, helper.Now())
+	return
+}
+
+// ApplyScheduledTransitions - Buka / tutup election sesuai start_at dan end_at tanpa admin.
+// Election terjadwal yang end_at-nya juga sudah lewat (misal setelah downtime) langsung dibuka lalu ditutup.
+// Row election dikunci dan kondisi jadwal dicek ulang, sehingga aman dijalankan bersamaan oleh beberapa instance
+func (u *ElectionUsecase) ApplyScheduledTransitions(ctx fiber.Ctx, id int) (res []entity.ElectionStatusLog, sysError syserror.SysError) {
+	defer func() {
+		if sysError == nil && len(res) > 0 {
+			u.publishStatusChanged(id, res[len(res)-1].ToStatus)
+		}
+	}()
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	for {
+		now := helper.Now()
+		election, errDue := u.electionRepo.GetDueElectionForUpdate(ctx, id, now)
+		if errDue != nil {
+			if errDue.GetStatusCode() != fiber.StatusNotFound {
+				sysError = errDue
+			}
+			return
+		}
+
+		status := entity.StatusOpen
+		if election.Status == entity.StatusOpen {
+			status = entity.StatusClosed
+		}
+
+		_, log, errApply := u.applyStatus(ctx, election, status, nil, "Otomatis sesuai jadwal", now)
+		if errApply != nil {
+			sysError = errApply
+			return
+		}
+		res = append(res, log)
+	}
+}
+
+// publishStatusChanged - Beri tahu stream live bahwa status election berubah
+func (u *ElectionUsecase) publishStatusChanged(id int, status string) {
+	u.redisDb.PublishElectionEvent(redisdb.ElectionEvent{Type: redisdb.EventStatusChanged, ElectionID: id, Status: status})
+}
+
+// applyStatus - Simpan status baru beserta waktu transisinya dan catat riwayatnya.
+// changedBy kosong berarti perubahan dilakukan sistem. Harus dipanggil di dalam transaction
+func (u *ElectionUsecase) applyStatus(ctx fiber.Ctx, election entity.Election, status string, changedBy *int, note string, now helper.CustomTime) (res entity.Election, log entity.ElectionStatusLog, sysError syserror.SysError) {
+	// ballot terenkripsi hanya bisa disimpan setelah seluruh trustee mengirim kontribusi kunci
+	if status == entity.StatusOpen {
+		var pending bool
+		if pending, sysError = u.electionRepo.HasPendingKeyCeremony(ctx, election.ID); sysError != nil {
+			return
+		}
+		if pending {
+			sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election tidak dapat dibuka sebelum seluruh trustee mengirim kontribusi kunci")
+			return
+		}
+	}
+
+	res, sysError = u.electionRepo.UpdateElectionStatus(ctx, election.ID, status, now)
+	if sysError != nil {
+		return
+	}
+
+	// kode voting yang belum dipakai tidak berlaku lagi setelah pemungutan suara ditutup,
+	// revote tag ikut dihapus karena ballot tidak bisa diganti lagi, dan pemakaian delegasi
+	// baru dicatat ke jejak audit sekarang supaya urutannya tidak mengikuti urutan suara
+	if status == entity.StatusClosed {
+		if sysError = u.electionRepo.RevokeVotingCodes(ctx, election.ID, now); sysError != nil {
+			return
+		}
+		if sysError = u.electionRepo.ClearRevoteTags(ctx, election.ID); sysError != nil {
+			return
+		}
+		if sysError = u.electionRepo.RecordUsedDelegationEvents(ctx, election.ID, now); sysError != nil {
+			return
+		}
+	}
+
+	log, sysError = u.electionRepo.CreateStatusLog(ctx, entity.ElectionStatusLog{
+		ElectionID: election.ID,
+		FromStatus: election.Status,
+		ToStatus:   status,
+		ChangedBy:  changedBy,
+		Note:       note,
+		ChangedAt:  now,
+	})
+	return
+}
+
+// GetStatusLogs - Riwayat perubahan status election (admin organization atau saksi)
+func (u *ElectionUsecase) GetStatusLogs(ctx fiber.Ctx, id int) (res []entity.ElectionStatusLog, sysError syserror.SysError) {
+	if _, _, sysError = u.GetObservableElection(ctx, id); sysError != nil {
+		return
+	}
+
+	res, sysError = u.electionRepo.GetStatusLogs(ctx, id)
+	return
+}
+
+// GetManagedElectionForUpdate - Sama seperti GetManagedElection tetapi mengunci row election
+// sehingga perubahan status tidak balapan dengan request lain. Harus dipanggil di dalam transaction
+func (u *ElectionUsecase) GetManagedElectionForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError) {
+	res, sysError = u.electionRepo.GetElectionByIDForUpdate(ctx, id)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.ensureOrganizationAdmin(ctx, res.OrganizationID)
+	return
+}
+
+func (u *ElectionUsecase) ensureOrganizationAdmin(ctx fiber.Ctx, organizationID int) syserror.SysError {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		return syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+	}
+
+	isAdmin, sysError := u.roleUse.IsOrganizationAdmin(ctx, userID, organizationID)
+	if sysError != nil {
+		return sysError
+	}
+	if !isAdmin {
+		return syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya admin organization yang dapat mengelola election")
+	}
+	return nil
+}
+
+func validateSchedule(startAt, endAt *helper.CustomTime) syserror.SysError {
+	if startAt != nil && endAt != nil && !startAt.Before(endAt.Time) {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "start_at harus lebih awal dari end_at")
+	}
+	return nil
+}
+
+// validateNominationWindow - Masa pendaftaran candidate harus lengkap (atau kosong sama sekali)
+// dan selesai sebelum pemungutan suara dimulai
+func validateNominationWindow(nominationStartAt, nominationEndAt, startAt *helper.CustomTime) syserror.SysError {
+	if nominationStartAt == nil && nominationEndAt == nil {
+		return nil
+	}
+	if nominationStartAt == nil || nominationEndAt == nil {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "nomination_start_at dan nomination_end_at harus diisi bersamaan")
+	}
+	if !nominationStartAt.Before(nominationEndAt.Time) {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "nomination_start_at harus lebih awal dari nomination_end_at")
+	}
+	if startAt != nil && nominationEndAt.After(startAt.Time) {
+		return syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "nomination_end_at tidak boleh melewati start_at")
+	}
+	return nil
+}
+
+// votingMethodOrDefault - election tanpa metode penghitungan memakai plurality
+func votingMethodOrDefault(method string) string {
+	if method == "" {
+		return string(tally.MethodPlurality)
+	}
+	return method
+}
+
+// candidateOrderOrDefault - urutan candidate yang tidak diatur memakai nomor urut
+func candidateOrderOrDefault(order string) string {
+	if order == "" {
+		return entity.CandidateOrderBallotNumber
+	}
+	return order
+}
+
+// disputeWindowOrDefault - masa sanggahan yang tidak diatur memakai DefaultDisputeWindowDays
+func disputeWindowOrDefault(days *int) int {
+	if days == nil {
+		return entity.DefaultDisputeWindowDays
+	}
+	return *days
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/repository"
+	organizationUsecase "github.com/madmuzz05/be-enyoblos/service/module/organization/usecase"
+	roleUsecase "github.com/madmuzz05/be-enyoblos/service/module/role/usecase"
+)
+
+type ElectionUsecase struct {
+	electionRepo    repository.IElectionRepository
+	organizationUse organizationUsecase.IOrganizationUsecase
+	roleUse         roleUsecase.IRoleUsecase
+	redisDb         *redisdb.RedisClient
+	mainDB          *dbpostgres.MainDB
+}
+
+func InitElectionUsecase(electionRepo repository.IElectionRepository, organizationUse organizationUsecase.IOrganizationUsecase, roleUse roleUsecase.IRoleUsecase, redisDb *redisdb.RedisClient, mainDB *dbpostgres.MainDB) IElectionUsecase {
+	return &ElectionUsecase{
+		electionRepo:    electionRepo,
+		organizationUse: organizationUse,
+		roleUse:         roleUse,
+		redisDb:         redisDb,
+		mainDB:          mainDB,
+	}
+}
+
+type IElectionUsecase interface {
+	GetElections(ctx fiber.Ctx, organizationID int, status string) (res []entity.Election, totalRecords int64, sysError syserror.SysError)
+	GetElectionByID(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetElectionByIDForShare(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetElectionByIDForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	IsNominationOpen(ctx fiber.Ctx, id int) (open bool, sysError syserror.SysError)
+	IsDisputeWindowOpen(ctx fiber.Ctx, id int) (open bool, sysError syserror.SysError)
+	SetUnderDispute(ctx fiber.Ctx, id int, underDispute bool) (sysError syserror.SysError)
+	GetManagedElection(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetObservableElection(ctx fiber.Ctx, id int) (res entity.Election, isAdmin bool, sysError syserror.SysError)
+	GetPollStationElection(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	GetManagedElectionForUpdate(ctx fiber.Ctx, id int) (res entity.Election, sysError syserror.SysError)
+	CreateElection(ctx fiber.Ctx, req dto.CreateElectionRequest) (res entity.Election, sysError syserror.SysError)
+	UpdateElection(ctx fiber.Ctx, id int, req dto.UpdateElectionRequest) (res entity.Election, sysError syserror.SysError)
+	DeleteElection(ctx fiber.Ctx, id int) (sysError syserror.SysError)
+	ChangeStatus(ctx fiber.Ctx, id int, req dto.ChangeElectionStatusRequest) (res entity.Election, sysError syserror.SysError)
+	GetDueElections(ctx fiber.Ctx) (res []entity.Election, sysError syserror.SysError)
+	ApplyScheduledTransitions(ctx fiber.Ctx, id int) (res []entity.ElectionStatusLog, sysError syserror.SysError)
+	GetStatusLogs(ctx fiber.Ctx, id int) (res []entity.ElectionStatusLog, sysError syserror.SysError)
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+)
+
+// RegisterKioskRequest - DTO untuk mendaftarkan perangkat kiosk TPS
+type RegisterKioskRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+// RegisterKioskResponse - Kiosk yang baru didaftarkan, kunci perangkat hanya dikembalikan sekali untuk ditanam di kiosk
+type RegisterKioskResponse struct {
+	Kiosk     entity.Kiosk `json:"kiosk"`
+	DeviceKey string       `json:"device_key"`
+}
+
+// AuthenticateKioskRequest - DTO untuk petugas TPS mengaktifkan kiosk memakai kunci perangkatnya
+type AuthenticateKioskRequest struct {
+	DeviceKey string `json:"device_key" validate:"required,max=64"`
+}
+
+// KioskTokenResponse - Kiosk token untuk perangkat kiosk yang sudah diaktifkan petugas TPS
+type KioskTokenResponse struct {
+	ElectionID int               `json:"election_id"`
+	KioskID    int               `json:"kiosk_id"`
+	KioskToken string            `json:"kiosk_token"`
+	ExpiresAt  helper.CustomTime `json:"expires_at"`
+}
+
+// UnlockBallotRequest - DTO untuk membuka satu surat suara bagi pemilih yang sudah check-in,
+// pemilih dicari dengan id entri DPT atau email / nomor anggota
+type UnlockBallotRequest struct {
+	VoterRollID int    `json:"voter_roll_id" validate:"required_without=Identity,omitempty,gt=0"`
+	Identity    string `json:"identity" validate:"required_without=VoterRollID,omitempty,max=255"`
+}
+
+// UnlockBallotResponse - Surat suara yang terbuka di kiosk beserta pemilihnya untuk dicocokkan petugas
+type UnlockBallotResponse struct {
+	KioskID      int               `json:"kiosk_id"`
+	VoterRollID  int               `json:"voter_roll_id"`
+	Name         string            `json:"name"`
+	MemberNumber *string           `json:"member_number"`
+	ExpiresAt    helper.CustomTime `json:"expires_at"`
+}
+
+// AddPollWorkerRequest - DTO untuk menunjuk user sebagai petugas TPS election
+type AddPollWorkerRequest struct {
+	UserID int `json:"user_id" validate:"required,gt=0"`
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// Kiosk - Perangkat kiosk TPS yang terdaftar untuk satu election. Kunci perangkat dan sesi kiosk token
+// hanya disimpan hash-nya. BallotsCast hanya jumlah, tidak ada catatan pemilih mana yang memilih di kiosk ini
+type Kiosk struct {
+	ID              int                `db:"id" json:"id"`
+	ElectionID      int                `db:"election_id" json:"election_id"`
+	Name            string             `db:"name" json:"name"`
+	DeviceKeyHash   string             `db:"device_key_hash" json:"-"`
+	SessionHash     *string            `db:"session_hash" json:"-"`
+	BallotsCast     int                `db:"ballots_cast" json:"ballots_cast"`
+	RegisteredBy    *int               `db:"registered_by" json:"registered_by"`
+	AuthenticatedBy *int               `db:"authenticated_by" json:"authenticated_by"`
+	AuthenticatedAt *helper.CustomTime `db:"authenticated_at" json:"authenticated_at"`
+	RevokedAt       *helper.CustomTime `db:"revoked_at" json:"revoked_at"`
+	CreatedAt       helper.CustomTime  `db:"created_at" json:"created_at"`
+	Unlocked        bool               `db:"unlocked" json:"unlocked"` // ada surat suara terbuka yang belum kedaluwarsa
+}
+
+func (Kiosk) TableName() string {
+	return "kiosk_devices"
+}
+
+// KioskUnlock - Surat suara yang dibuka petugas TPS di satu kiosk untuk satu pemilih yang sudah check-in.
+// Baris dihapus saat suara disimpan, dibatalkan, atau digantikan setelah kedaluwarsa
+type KioskUnlock struct {
+	ID          int               `db:"id" json:"id"`
+	KioskID     int               `db:"kiosk_id" json:"kiosk_id"`
+	ElectionID  int               `db:"election_id" json:"election_id"`
+	VoterRollID int               `db:"voter_roll_id" json:"voter_roll_id"`
+	UnlockedBy  *int              `db:"unlocked_by" json:"unlocked_by"`
+	ExpiresAt   helper.CustomTime `db:"expires_at" json:"expires_at"`
+	CreatedAt   helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (KioskUnlock) TableName() string {
+	return "kiosk_unlocks"
+}
+
+// PollWorker - Petugas TPS election, disimpan sebagai role poll_worker di users_has_roles yang terikat ke satu election
+type PollWorker struct {
+	ID             int    `db:"id" json:"id"`
+	ElectionID     int    `db:"election_id" json:"election_id"`
+	UserID         int    `db:"user_id" json:"user_id"`
+	OrganizationID int    `db:"organization_id" json:"organization_id"`
+	Name           string `db:"name" json:"name"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (PollWorker) TableName() string {
+	return "users_has_roles"
+}
+
+// HashSecret - Hash sha256 (hex) dari kunci perangkat atau sesi kiosk
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
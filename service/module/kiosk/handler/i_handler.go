@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/kiosk/usecase"
+
+type KioskHandler struct {
+	KioskUsecase usecase.IKioskUsecase
+}
+
+func InitKioskHandler(kioskUsecase usecase.IKioskUsecase) *KioskHandler {
+	return &KioskHandler{
+		KioskUsecase: kioskUsecase,
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/dto"
+)
+
+// GetKiosks - Daftar kiosk election
+// @GET /elections/:id/kiosks
+func (h *KioskHandler) GetKiosks(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.KioskUsecase.GetKiosks(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Kiosks retrieved successfully", res)
+}
+
+// RegisterKiosk - Daftarkan perangkat kiosk TPS
+// @POST /elections/:id/kiosks
+// Body: {name: string}
+func (h *KioskHandler) RegisterKiosk(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.RegisterKioskRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.KioskUsecase.RegisterKiosk(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Kiosk registered successfully", res)
+}
+
+// RevokeKiosk - Nonaktifkan kiosk
+// @DELETE /elections/:id/kiosks/:kiosk_id
+func (h *KioskHandler) RevokeKiosk(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	kioskID, err := strconv.Atoi(ctx.Params("kiosk_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid kiosk ID", err)
+	}
+
+	if sysErr := h.KioskUsecase.RevokeKiosk(ctx, electionID, kioskID); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Kiosk revoked successfully", nil)
+}
+
+// AuthenticateKiosk - Aktifkan kiosk dan dapatkan kiosk token
+// @POST /elections/:id/kiosks/:kiosk_id/authenticate
+// Body: {device_key: string}
+func (h *KioskHandler) AuthenticateKiosk(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	kioskID, err := strconv.Atoi(ctx.Params("kiosk_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid kiosk ID", err)
+	}
+
+	var req dto.AuthenticateKioskRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.KioskUsecase.AuthenticateKiosk(ctx, electionID, kioskID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Kiosk authenticated successfully", res)
+}
+
+// UnlockBallot - Buka satu surat suara di kiosk untuk pemilih yang sudah check-in
+// @POST /elections/:id/kiosks/:kiosk_id/unlock
+// Body: {voter_roll_id?: int, identity?: string}
+func (h *KioskHandler) UnlockBallot(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	kioskID, err := strconv.Atoi(ctx.Params("kiosk_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid kiosk ID", err)
+	}
+
+	var req dto.UnlockBallotRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.KioskUsecase.UnlockBallot(ctx, electionID, kioskID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Ballot unlocked successfully", res)
+}
+
+// CancelUnlock - Batalkan surat suara yang terbuka di kiosk
+// @DELETE /elections/:id/kiosks/:kiosk_id/unlock
+func (h *KioskHandler) CancelUnlock(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	kioskID, err := strconv.Atoi(ctx.Params("kiosk_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid kiosk ID", err)
+	}
+
+	if sysErr := h.KioskUsecase.CancelUnlock(ctx, electionID, kioskID); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Ballot unlock cancelled successfully", nil)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/dto"
+)
+
+// GetPollWorkers - Daftar petugas TPS election
+// @GET /elections/:id/poll-workers
+func (h *KioskHandler) GetPollWorkers(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.KioskUsecase.GetPollWorkers(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Poll workers retrieved successfully", res)
+}
+
+// AddPollWorker - Tunjuk petugas TPS election
+// @POST /elections/:id/poll-workers
+// Body: {user_id: int}
+func (h *KioskHandler) AddPollWorker(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.AddPollWorkerRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.KioskUsecase.AddPollWorker(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Poll worker added successfully", res)
+}
+
+// DeletePollWorker - Cabut petugas TPS election
+// @DELETE /elections/:id/poll-workers/:poll_worker_id
+func (h *KioskHandler) DeletePollWorker(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	pollWorkerID, err := strconv.Atoi(ctx.Params("poll_worker_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid poll worker ID", err)
+	}
+
+	if sysErr := h.KioskUsecase.DeletePollWorker(ctx, electionID, pollWorkerID); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Poll worker deleted successfully", nil)
+}
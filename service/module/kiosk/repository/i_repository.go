@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+)
+
+type KioskRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitKioskRepository(mainDB *database.MainDB) IKioskRepository {
+	return &KioskRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *KioskRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IKioskRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetKiosks(ctx fiber.Ctx, electionID int, now helper.CustomTime) (res []entity.Kiosk, sysError syserror.SysError)
+	GetKioskByID(ctx fiber.Ctx, electionID int, id int) (res entity.Kiosk, sysError syserror.SysError)
+	GetKioskByIDForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Kiosk, sysError syserror.SysError)
+	CreateKiosk(ctx fiber.Ctx, kiosk entity.Kiosk) (res entity.Kiosk, sysError syserror.SysError)
+	UpdateKioskSession(ctx fiber.Ctx, id int, sessionHash string, authenticatedBy int, authenticatedAt helper.CustomTime) (sysError syserror.SysError)
+	RevokeKiosk(ctx fiber.Ctx, id int, revokedAt helper.CustomTime) (sysError syserror.SysError)
+
+	GetUnlockByKioskID(ctx fiber.Ctx, kioskID int, now helper.CustomTime) (res entity.KioskUnlock, sysError syserror.SysError)
+	DeleteExpiredUnlocks(ctx fiber.Ctx, kioskID int, voterRollID int, now helper.CustomTime) (sysError syserror.SysError)
+	CreateUnlock(ctx fiber.Ctx, unlock entity.KioskUnlock) (res entity.KioskUnlock, sysError syserror.SysError)
+	DeleteUnlock(ctx fiber.Ctx, kioskID int) (sysError syserror.SysError)
+	ConsumeUnlock(ctx fiber.Ctx, kioskID int, now helper.CustomTime) (voterRollID int, sysError syserror.SysError)
+	HasVoted(ctx fiber.Ctx, electionID int, voterRollID int) (voted bool, sysError syserror.SysError)
+
+	GetPollWorkers(ctx fiber.Ctx, electionID int) (res []entity.PollWorker, sysError syserror.SysError)
+	CreatePollWorker(ctx fiber.Ctx, pollWorker entity.PollWorker) (res entity.PollWorker, sysError syserror.SysError)
+	DeletePollWorker(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
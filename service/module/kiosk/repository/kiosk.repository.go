@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+)
+
+const kioskColumns = `id, election_id, name, device_key_hash, session_hash, ballots_cast, registered_by, authenticated_by, authenticated_at, revoked_at, created_at`
+
+const unlockColumns = `id, kiosk_id, election_id, voter_roll_id, unlocked_by, expires_at, created_at`
+
+func (r *KioskRepository) GetKiosks(ctx fiber.Ctx, electionID int, now helper.CustomTime) (res []entity.Kiosk, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + kioskColumns + `,
+	                 EXISTS (SELECT 1 FROM public.kiosk_unlocks u WHERE u.kiosk_id = k.id AND u.expires_at > $2) AS unlocked
+	          FROM public.kiosk_devices k
+	          WHERE k.election_id = $1
+	          ORDER BY k.name, k.id`
+
+	model := db.Select(&res, query, electionID, now)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kiosk")
+		return
+	}
+	return
+}
+
+func (r *KioskRepository) GetKioskByID(ctx fiber.Ctx, electionID int, id int) (res entity.Kiosk, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + kioskColumns + ` FROM public.kiosk_devices WHERE id = $1 AND election_id = $2`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Kiosk tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kiosk")
+	}
+	return
+}
+
+// GetKioskByIDForUpdate - Kunci kiosk supaya pembukaan dan pemakaian surat suara di kiosk yang sama berjalan berurutan
+func (r *KioskRepository) GetKioskByIDForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Kiosk, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + kioskColumns + ` FROM public.kiosk_devices WHERE id = $1 AND election_id = $2 FOR UPDATE`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Kiosk tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kiosk")
+	}
+	return
+}
+
+func (r *KioskRepository) CreateKiosk(ctx fiber.Ctx, kiosk entity.Kiosk) (res entity.Kiosk, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.kiosk_devices (election_id, name, device_key_hash, registered_by, created_at)
+	          VALUES ($1, $2, $3, $4, $5)
+	          RETURNING ` + kioskColumns
+
+	model := db.Get(&res, query, kiosk.ElectionID, kiosk.Name, kiosk.DeviceKeyHash, kiosk.RegisteredBy, kiosk.CreatedAt)
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Nama kiosk sudah dipakai di election ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mendaftarkan kiosk")
+	}
+	return
+}
+
+// UpdateKioskSession - Simpan sesi kiosk token baru, token dari aktivasi sebelumnya tidak berlaku lagi
+func (r *KioskRepository) UpdateKioskSession(ctx fiber.Ctx, id int, sessionHash string, authenticatedBy int, authenticatedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.kiosk_devices SET session_hash = $1, authenticated_by = $2, authenticated_at = $3 WHERE id = $4`
+
+	if _, err := db.Exec(query, sessionHash, authenticatedBy, authenticatedAt, id); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengaktifkan kiosk")
+	}
+	return
+}
+
+// RevokeKiosk - Nonaktifkan kiosk beserta kiosk token dan surat suara yang masih terbuka
+func (r *KioskRepository) RevokeKiosk(ctx fiber.Ctx, id int, revokedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH cleared AS (
+	              DELETE FROM public.kiosk_unlocks WHERE kiosk_id = $2
+	          )
+	          UPDATE public.kiosk_devices SET revoked_at = $1, session_hash = NULL WHERE id = $2`
+
+	if _, err := db.Exec(query, revokedAt, id); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menonaktifkan kiosk")
+	}
+	return
+}
+
+// GetUnlockByKioskID - Surat suara terbuka di kiosk yang belum kedaluwarsa
+func (r *KioskRepository) GetUnlockByKioskID(ctx fiber.Ctx, kioskID int, now helper.CustomTime) (res entity.KioskUnlock, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + unlockColumns + ` FROM public.kiosk_unlocks WHERE kiosk_id = $1 AND expires_at > $2`
+
+	model := db.Get(&res, query, kioskID, now)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Belum ada surat suara yang dibuka di kiosk ini atau surat suara sudah kedaluwarsa")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil surat suara kiosk")
+	}
+	return
+}
+
+// DeleteExpiredUnlocks - Hapus surat suara kedaluwarsa milik kiosk atau pemilih supaya bisa dibuka ulang
+func (r *KioskRepository) DeleteExpiredUnlocks(ctx fiber.Ctx, kioskID int, voterRollID int, now helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.kiosk_unlocks WHERE (kiosk_id = $1 OR voter_roll_id = $2) AND expires_at <= $3`
+
+	if _, err := db.Exec(query, kioskID, voterRollID, now); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus surat suara kiosk yang kedaluwarsa")
+	}
+	return
+}
+
+func (r *KioskRepository) CreateUnlock(ctx fiber.Ctx, unlock entity.KioskUnlock) (res entity.KioskUnlock, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.kiosk_unlocks (kiosk_id, election_id, voter_roll_id, unlocked_by, expires_at, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6)
+	          RETURNING ` + unlockColumns
+
+	model := db.Get(&res, query, unlock.KioskID, unlock.ElectionID, unlock.VoterRollID, unlock.UnlockedBy, unlock.ExpiresAt, unlock.CreatedAt)
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Kiosk atau pemilih masih memiliki surat suara yang terbuka")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuka surat suara")
+	}
+	return
+}
+
+func (r *KioskRepository) DeleteUnlock(ctx fiber.Ctx, kioskID int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.kiosk_unlocks WHERE kiosk_id = $1`
+
+	result, err := db.Exec(query, kioskID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membatalkan surat suara kiosk")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Belum ada surat suara yang dibuka di kiosk ini")
+		return
+	}
+	return
+}
+
+// ConsumeUnlock - Pakai surat suara terbuka yang belum kedaluwarsa dan tambah hitungan suara kiosk.
+// Baris unlock dihapus sehingga tidak ada jejak urutan pemilih di kiosk
+func (r *KioskRepository) ConsumeUnlock(ctx fiber.Ctx, kioskID int, now helper.CustomTime) (voterRollID int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH used AS (
+	              DELETE FROM public.kiosk_unlocks WHERE kiosk_id = $1 AND expires_at > $2
+	              RETURNING voter_roll_id
+	          ), counted AS (
+	              UPDATE public.kiosk_devices SET ballots_cast = ballots_cast + 1
+	              WHERE id = $1 AND EXISTS (SELECT 1 FROM used)
+	          )
+	          SELECT voter_roll_id FROM used`
+
+	model := db.Get(&voterRollID, query, kioskID, now)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Belum ada surat suara yang dibuka di kiosk ini atau surat suara sudah kedaluwarsa")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal memakai surat suara kiosk")
+	}
+	return
+}
+
+// HasVoted - Cek apakah entri DPT sudah tercatat memberikan suara
+func (r *KioskRepository) HasVoted(ctx fiber.Ctx, electionID int, voterRollID int) (voted bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (SELECT 1 FROM public.election_participations WHERE election_id = $1 AND voter_roll_id = $2)`
+
+	model := db.Get(&voted, query, electionID, voterRollID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil status pemilih")
+	}
+	return
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+	roleEntity "github.com/madmuzz05/be-enyoblos/service/module/role/entity"
+)
+
+func (r *KioskRepository) GetPollWorkers(ctx fiber.Ctx, electionID int) (res []entity.PollWorker, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT uhr.id, uhr.election_id, uhr.user_id, uhr.organization_id, u.name, u.email
+	          FROM public.users_has_roles uhr
+	          JOIN public.roles r ON r.id = uhr.role_id
+	          JOIN public.users u ON u.id = uhr.user_id
+	          WHERE uhr.election_id = $1 AND r.name = $2
+	          ORDER BY uhr.id`
+
+	model := db.Select(&res, query, electionID, roleEntity.RolePollWorker)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil petugas TPS")
+		return
+	}
+	return
+}
+
+// CreatePollWorker - Beri user role poll_worker yang terikat ke election
+func (r *KioskRepository) CreatePollWorker(ctx fiber.Ctx, pollWorker entity.PollWorker) (res entity.PollWorker, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH inserted AS (
+	              INSERT INTO public.users_has_roles (user_id, role_id, organization_id, election_id)
+	              SELECT $1, r.id, $2, $3 FROM public.roles r WHERE r.name = $4
+	              RETURNING id, election_id, user_id, organization_id
+	          )
+	          SELECT i.id, i.election_id, i.user_id, i.organization_id, u.name, u.email
+	          FROM inserted i
+	          JOIN public.users u ON u.id = i.user_id`
+
+	model := db.Get(&res, query, pollWorker.UserID, pollWorker.OrganizationID, pollWorker.ElectionID, roleEntity.RolePollWorker)
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "User sudah menjadi petugas TPS election ini")
+		return
+	} else if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Role poll_worker belum tersedia")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menambahkan petugas TPS")
+	}
+	return
+}
+
+func (r *KioskRepository) DeletePollWorker(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.users_has_roles uhr
+	          USING public.roles r
+	          WHERE r.id = uhr.role_id AND uhr.id = $1 AND uhr.election_id = $2 AND r.name = $3`
+
+	result, err := db.Exec(query, id, electionID, roleEntity.RolePollWorker)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus petugas TPS")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Petugas TPS tidak ditemukan")
+		return
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/repository"
+	userUsecase "github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
+	voterRollUsecase "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+)
+
+type KioskUsecase struct {
+	kioskRepo    repository.IKioskRepository
+	electionUse  electionUsecase.IElectionUsecase
+	voterRollUse voterRollUsecase.IVoterRollUsecase
+	userUse      userUsecase.IUserUsecase
+	mainDB       *dbpostgres.MainDB
+}
+
+func InitKioskUsecase(kioskRepo repository.IKioskRepository, electionUse electionUsecase.IElectionUsecase, voterRollUse voterRollUsecase.IVoterRollUsecase, userUse userUsecase.IUserUsecase, mainDB *dbpostgres.MainDB) IKioskUsecase {
+	return &KioskUsecase{
+		kioskRepo:    kioskRepo,
+		electionUse:  electionUse,
+		voterRollUse: voterRollUse,
+		userUse:      userUse,
+		mainDB:       mainDB,
+	}
+}
+
+type IKioskUsecase interface {
+	GetKiosks(ctx fiber.Ctx, electionID int) (res []entity.Kiosk, sysError syserror.SysError)
+	RegisterKiosk(ctx fiber.Ctx, electionID int, req dto.RegisterKioskRequest) (res dto.RegisterKioskResponse, sysError syserror.SysError)
+	RevokeKiosk(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	AuthenticateKiosk(ctx fiber.Ctx, electionID int, id int, req dto.AuthenticateKioskRequest) (res dto.KioskTokenResponse, sysError syserror.SysError)
+	UnlockBallot(ctx fiber.Ctx, electionID int, id int, req dto.UnlockBallotRequest) (res dto.UnlockBallotResponse, sysError syserror.SysError)
+	CancelUnlock(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	GetUnlockedVoter(ctx fiber.Ctx, electionID int) (voterRollID int, sysError syserror.SysError)
+	RedeemUnlock(ctx fiber.Ctx, electionID int) (voterRollID int, sysError syserror.SysError)
+
+	GetPollWorkers(ctx fiber.Ctx, electionID int) (res []entity.PollWorker, sysError syserror.SysError)
+	AddPollWorker(ctx fiber.Ctx, electionID int, req dto.AddPollWorkerRequest) (res entity.PollWorker, sysError syserror.SysError)
+	DeletePollWorker(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+	voterRollEntity "github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+const (
+	// deviceKeyLength - panjang kunci perangkat kiosk yang ditanam saat pendaftaran
+	deviceKeyLength = 32
+	// sessionLength - panjang sesi kiosk token, dibuat ulang setiap kali kiosk diaktifkan
+	sessionLength = 32
+	// unlockTTL - batas waktu pemilih memakai surat suara yang sudah dibuka petugas
+	unlockTTL = 10 * time.Minute
+)
+
+// GetKiosks - Daftar kiosk election beserta status surat suara terbuka (admin organization atau petugas TPS)
+func (u *KioskUsecase) GetKiosks(ctx fiber.Ctx, electionID int) (res []entity.Kiosk, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetPollStationElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.kioskRepo.GetKiosks(ctx, electionID, helper.Now())
+	return
+}
+
+// RegisterKiosk - Daftarkan perangkat kiosk TPS (admin organization).
+// Kunci perangkat hanya dikembalikan di response ini, database hanya menyimpan hash-nya
+func (u *KioskUsecase) RegisterKiosk(ctx fiber.Ctx, electionID int, req dto.RegisterKioskRequest) (res dto.RegisterKioskResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetManagedElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	switch election.Status {
+	case electionEntity.StatusDraft, electionEntity.StatusScheduled, electionEntity.StatusOpen:
+	default:
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk tidak dapat didaftarkan setelah election ditutup")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	deviceKey, err := helper.RandomCode(deviceKeyLength)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat kunci perangkat kiosk")
+		return
+	}
+
+	kiosk, sysError := u.kioskRepo.CreateKiosk(ctx, entity.Kiosk{
+		ElectionID:    electionID,
+		Name:          req.Name,
+		DeviceKeyHash: entity.HashSecret(deviceKey),
+		RegisteredBy:  &userID,
+		CreatedAt:     helper.Now(),
+	})
+	if sysError != nil {
+		return
+	}
+
+	res = dto.RegisterKioskResponse{
+		Kiosk:     kiosk,
+		DeviceKey: deviceKey,
+	}
+	return
+}
+
+// RevokeKiosk - Nonaktifkan kiosk (admin organization), kiosk token dan surat suara yang terbuka ikut batal
+func (u *KioskUsecase) RevokeKiosk(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	kiosk, sysError := u.kioskRepo.GetKioskByIDForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if kiosk.RevokedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk sudah dinonaktifkan")
+		return
+	}
+
+	sysError = u.kioskRepo.RevokeKiosk(ctx, kiosk.ID, helper.Now())
+	return
+}
+
+// AuthenticateKiosk - Petugas TPS mengaktifkan kiosk dengan kunci perangkatnya dan mendapatkan kiosk token.
+// Aktivasi ulang membuat sesi baru sehingga kiosk token sebelumnya tidak berlaku lagi
+func (u *KioskUsecase) AuthenticateKiosk(ctx fiber.Ctx, electionID int, id int, req dto.AuthenticateKioskRequest) (res dto.KioskTokenResponse, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetPollStationElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusScheduled && election.Status != electionEntity.StatusOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk hanya dapat diaktifkan saat election dijadwalkan atau dibuka")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	kiosk, sysError := u.kioskRepo.GetKioskByIDForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if kiosk.RevokedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk sudah dinonaktifkan")
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(kiosk.DeviceKeyHash), []byte(entity.HashSecret(req.DeviceKey))) != 1 {
+		sysError = syserror.CreateError(fiber.ErrUnauthorized, fiber.StatusUnauthorized, "Kunci perangkat kiosk tidak valid")
+		return
+	}
+
+	session, err := helper.RandomCode(sessionLength)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat sesi kiosk")
+		return
+	}
+	if sysError = u.kioskRepo.UpdateKioskSession(ctx, kiosk.ID, entity.HashSecret(session), userID, helper.Now()); sysError != nil {
+		return
+	}
+
+	token, err := middleware.GenerateKioskToken(electionID, kiosk.ID, session)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat kiosk token")
+		return
+	}
+
+	res = dto.KioskTokenResponse{
+		ElectionID: electionID,
+		KioskID:    kiosk.ID,
+		KioskToken: token.AccessToken,
+		ExpiresAt:  token.ExpiresIn,
+	}
+	return
+}
+
+// UnlockBallot - Petugas TPS membuka satu surat suara di kiosk untuk pemilih yang sudah check-in.
+// Kiosk hanya bisa memegang satu surat suara terbuka dan surat suara itu hanya bisa dipakai sekali
+func (u *KioskUsecase) UnlockBallot(ctx fiber.Ctx, electionID int, id int, req dto.UnlockBallotRequest) (res dto.UnlockBallotResponse, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetPollStationElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election tidak sedang dibuka untuk pemungutan suara")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	kiosk, sysError := u.kioskRepo.GetKioskByIDForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if kiosk.RevokedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk sudah dinonaktifkan")
+		return
+	}
+	if kiosk.SessionHash == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kiosk belum diaktifkan petugas TPS")
+		return
+	}
+
+	voter, sysError := u.checkInVoter(ctx, electionID, req)
+	if sysError != nil {
+		return
+	}
+
+	voted, sysError := u.kioskRepo.HasVoted(ctx, electionID, voter.ID)
+	if sysError != nil {
+		return
+	}
+	if voted && !election.AllowRevote {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Pemilih sudah memberikan suara")
+		return
+	}
+
+	now := helper.Now()
+	if sysError = u.kioskRepo.DeleteExpiredUnlocks(ctx, kiosk.ID, voter.ID, now); sysError != nil {
+		return
+	}
+
+	unlock, sysError := u.kioskRepo.CreateUnlock(ctx, entity.KioskUnlock{
+		KioskID:     kiosk.ID,
+		ElectionID:  electionID,
+		VoterRollID: voter.ID,
+		UnlockedBy:  &userID,
+		ExpiresAt:   helper.CustomTime{Time: now.Time.Add(unlockTTL)},
+		CreatedAt:   now,
+	})
+	if sysError != nil {
+		return
+	}
+
+	res = dto.UnlockBallotResponse{
+		KioskID:      kiosk.ID,
+		VoterRollID:  voter.ID,
+		Name:         voter.Name,
+		MemberNumber: voter.MemberNumber,
+		ExpiresAt:    unlock.ExpiresAt,
+	}
+	return
+}
+
+// checkInVoter - Cari entri DPT pemilih yang datang ke TPS berdasarkan id atau email / nomor anggota
+func (u *KioskUsecase) checkInVoter(ctx fiber.Ctx, electionID int, req dto.UnlockBallotRequest) (voter voterRollEntity.VoterRoll, sysError syserror.SysError) {
+	if req.VoterRollID > 0 {
+		voter, sysError = u.voterRollUse.GetVoterRollByID(ctx, electionID, req.VoterRollID)
+	} else {
+		voter, sysError = u.voterRollUse.GetVoterRollByIdentity(ctx, electionID, req.Identity)
+	}
+	if sysError != nil && sysError.GetStatusCode() == fiber.StatusNotFound {
+		sysError = syserror.CreateError(sysError.GetError(), fiber.StatusNotFound, "Pemilih tidak terdaftar dalam DPT election ini")
+	}
+	return
+}
+
+// CancelUnlock - Batalkan surat suara yang terbuka di kiosk, misal pemilih batal memilih (admin organization atau petugas TPS)
+func (u *KioskUsecase) CancelUnlock(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetPollStationElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	if _, sysError = u.kioskRepo.GetKioskByID(ctx, electionID, id); sysError != nil {
+		return
+	}
+
+	sysError = u.kioskRepo.DeleteUnlock(ctx, id)
+	return
+}
+
+// GetUnlockedVoter - Entri DPT pemilih yang surat suaranya sedang terbuka di kiosk pemegang kiosk token
+func (u *KioskUsecase) GetUnlockedVoter(ctx fiber.Ctx, electionID int) (voterRollID int, sysError syserror.SysError) {
+	kiosk, sysError := u.getTokenKiosk(ctx, electionID, false)
+	if sysError != nil {
+		return
+	}
+
+	unlock, sysError := u.kioskRepo.GetUnlockByKioskID(ctx, kiosk.ID, helper.Now())
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusConflict, sysError.GetMessage())
+		}
+		return
+	}
+
+	voterRollID = unlock.VoterRollID
+	return
+}
+
+// RedeemUnlock - Pakai surat suara terbuka di kiosk pemegang kiosk token untuk satu suara.
+// Harus dipanggil di dalam transaction penyimpanan suara sehingga surat suara kembali terbuka jika suara gagal disimpan
+func (u *KioskUsecase) RedeemUnlock(ctx fiber.Ctx, electionID int) (voterRollID int, sysError syserror.SysError) {
+	kiosk, sysError := u.getTokenKiosk(ctx, electionID, true)
+	if sysError != nil {
+		return
+	}
+
+	voterRollID, sysError = u.kioskRepo.ConsumeUnlock(ctx, kiosk.ID, helper.Now())
+	return
+}
+
+// getTokenKiosk - Kiosk milik kiosk token, pastikan belum dinonaktifkan dan sesi token masih yang terakhir
+func (u *KioskUsecase) getTokenKiosk(ctx fiber.Ctx, electionID int, forUpdate bool) (kiosk entity.Kiosk, sysError syserror.SysError) {
+	claims, err := middleware.GetKioskClaims(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "Kiosk token tidak valid")
+		return
+	}
+	if claims.ElectionID != electionID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Kiosk token bukan untuk election ini")
+		return
+	}
+
+	if forUpdate {
+		kiosk, sysError = u.kioskRepo.GetKioskByIDForUpdate(ctx, electionID, claims.KioskID)
+	} else {
+		kiosk, sysError = u.kioskRepo.GetKioskByID(ctx, electionID, claims.KioskID)
+	}
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusUnauthorized, "Kiosk token sudah tidak berlaku")
+		}
+		return
+	}
+
+	if kiosk.RevokedAt != nil || kiosk.SessionHash == nil ||
+		subtle.ConstantTimeCompare([]byte(*kiosk.SessionHash), []byte(entity.HashSecret(claims.Session))) != 1 {
+		sysError = syserror.CreateError(fiber.ErrUnauthorized, fiber.StatusUnauthorized, "Kiosk token sudah tidak berlaku")
+	}
+	return
+}
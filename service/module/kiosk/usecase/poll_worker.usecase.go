@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/entity"
+)
+
+// GetPollWorkers - Daftar petugas TPS election (admin organization)
+func (u *KioskUsecase) GetPollWorkers(ctx fiber.Ctx, electionID int) (res []entity.PollWorker, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.kioskRepo.GetPollWorkers(ctx, electionID)
+	return
+}
+
+// AddPollWorker - Tunjuk user sebagai petugas TPS election, role-nya dicatat atas organization penyelenggara
+func (u *KioskUsecase) AddPollWorker(ctx fiber.Ctx, electionID int, req dto.AddPollWorkerRequest) (res entity.PollWorker, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	if _, sysError = u.userUse.GetUserByID(ctx, strconv.Itoa(req.UserID)); sysError != nil {
+		return
+	}
+
+	res, sysError = u.kioskRepo.CreatePollWorker(ctx, entity.PollWorker{
+		ElectionID:     electionID,
+		UserID:         req.UserID,
+		OrganizationID: election.OrganizationID,
+	})
+	return
+}
+
+// DeletePollWorker - Cabut penunjukan petugas TPS election (admin organization)
+func (u *KioskUsecase) DeletePollWorker(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	sysError = u.kioskRepo.DeletePollWorker(ctx, electionID, id)
+	return
+}
@@ -0,0 +1,24 @@
+package dto
+
+// CreateNominationRequest - DTO pendaftaran candidate. nominee_user_id kosong berarti mendaftarkan diri sendiri,
+// name kosong memakai nama user yang didaftarkan, contest_id boleh kosong jika election hanya memiliki satu contest
+type CreateNominationRequest struct {
+	ContestID           int     `json:"contest_id" validate:"omitempty,gt=0"`
+	NomineeUserID       int     `json:"nominee_user_id" validate:"omitempty,gt=0"`
+	Name                string  `json:"name" validate:"omitempty,max=255"`
+	PhotoURL            string  `json:"photo_url"`
+	Vision              string  `json:"vision"`
+	Mission             string  `json:"mission"`
+	RunningMateName     *string `json:"running_mate_name" validate:"omitempty,max=255"`
+	RunningMatePhotoURL *string `json:"running_mate_photo_url"`
+}
+
+// ApproveNominationRequest - ballot_number kosong berarti diisi nomor urut berikutnya pada contest
+type ApproveNominationRequest struct {
+	BallotNumber int `json:"ballot_number" validate:"omitempty,gt=0"`
+}
+
+// RejectNominationRequest - Alasan penolakan wajib diisi dan dikirim ke anggota yang didaftarkan
+type RejectNominationRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	StatusPending   = "pending"
+	StatusApproved  = "approved" // sudah masuk tabel candidates, CandidateID terisi
+	StatusRejected  = "rejected"
+	StatusWithdrawn = "withdrawn"
+)
+
+// Nomination - Pendaftaran anggota organization sebagai candidate pada satu contest.
+// Baru tampil di surat suara setelah disetujui panitia (admin organization)
+type Nomination struct {
+	ID                  int                `db:"id" json:"id"`
+	ElectionID          int                `db:"election_id" json:"election_id"`
+	ContestID           int                `db:"contest_id" json:"contest_id"`
+	NomineeUserID       int                `db:"nominee_user_id" json:"nominee_user_id"`
+	NominatedBy         *int               `db:"nominated_by" json:"nominated_by"`
+	Name                string             `db:"name" json:"name"`
+	PhotoURL            string             `db:"photo_url" json:"photo_url"`
+	Vision              string             `db:"vision" json:"vision"`
+	Mission             string             `db:"mission" json:"mission"`
+	RunningMateName     *string            `db:"running_mate_name" json:"running_mate_name"`
+	RunningMatePhotoURL *string            `db:"running_mate_photo_url" json:"running_mate_photo_url"`
+	Status              string             `db:"status" json:"status"`
+	ReviewReason        *string            `db:"review_reason" json:"review_reason"`
+	ReviewedBy          *int               `db:"reviewed_by" json:"reviewed_by"`
+	ReviewedAt          *helper.CustomTime `db:"reviewed_at" json:"reviewed_at"`
+	CandidateID         *int               `db:"candidate_id" json:"candidate_id"`
+	CreatedAt           helper.CustomTime  `db:"created_at" json:"created_at"`
+	UpdatedAt           helper.CustomTime  `db:"updated_at" json:"updated_at"`
+}
+
+func (Nomination) TableName() string {
+	return "candidate_nominations"
+}
+
+// NominationDetail - Pendaftaran beserta identitas anggota yang didaftarkan dan daftar dokumennya
+type NominationDetail struct {
+	Nomination
+	NomineeName  string               `db:"nominee_name" json:"nominee_name"`
+	NomineeEmail string               `db:"nominee_email" json:"nominee_email"`
+	Documents    []NominationDocument `db:"-" json:"documents,omitempty"`
+}
+
+// NominationDocument - Dokumen pendukung pendaftaran. Content hanya diisi saat dokumen diunduh
+type NominationDocument struct {
+	ID           int               `db:"id" json:"id"`
+	NominationID int               `db:"nomination_id" json:"nomination_id"`
+	FileName     string            `db:"file_name" json:"file_name"`
+	ContentType  string            `db:"content_type" json:"content_type"`
+	Size         int               `db:"size" json:"size"`
+	SHA256       string            `db:"sha256" json:"sha256"`
+	Content      []byte            `db:"content" json:"-"`
+	UploadedBy   *int              `db:"uploaded_by" json:"uploaded_by"`
+	CreatedAt    helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (NominationDocument) TableName() string {
+	return "nomination_documents"
+}
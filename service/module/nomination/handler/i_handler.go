@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/nomination/usecase"
+
+type NominationHandler struct {
+	NominationUsecase usecase.INominationUsecase
+}
+
+func InitNominationHandler(nominationUsecase usecase.INominationUsecase) *NominationHandler {
+	return &NominationHandler{
+		NominationUsecase: nominationUsecase,
+	}
+}
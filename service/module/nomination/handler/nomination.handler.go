@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/dto"
+)
+
+// GetNominations - Daftar pendaftaran candidate election, bisa difilter status
+// @GET /elections/:id/nominations?status=
+func (h *NominationHandler) GetNominations(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.NominationUsecase.GetNominations(ctx, electionID, ctx.Query("status"))
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nominations retrieved successfully", res)
+}
+
+// GetMyNominations - Pendaftaran candidate milik user yang login
+// @GET /elections/:id/nominations/me
+func (h *NominationHandler) GetMyNominations(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.NominationUsecase.GetMyNominations(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nominations retrieved successfully", res)
+}
+
+// GetNominationByID - Detail pendaftaran candidate beserta daftar dokumen
+// @GET /elections/:id/nominations/:nomination_id
+func (h *NominationHandler) GetNominationByID(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+
+	res, sysErr := h.NominationUsecase.GetNominationByID(ctx, electionID, nominationID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nomination retrieved successfully", res)
+}
+
+// CreateNomination - Daftarkan diri sendiri atau anggota lain sebagai candidate
+// @POST /elections/:id/nominations
+// Body: {contest_id?: int, nominee_user_id?: int, name?: string, photo_url, vision, mission, running_mate_name, running_mate_photo_url}
+func (h *NominationHandler) CreateNomination(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.CreateNominationRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.NominationUsecase.CreateNomination(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Nomination submitted successfully", res)
+}
+
+// WithdrawNomination - Batalkan pendaftaran yang belum direview
+// @POST /elections/:id/nominations/:nomination_id/withdraw
+func (h *NominationHandler) WithdrawNomination(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+
+	res, sysErr := h.NominationUsecase.WithdrawNomination(ctx, electionID, nominationID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nomination withdrawn successfully", res)
+}
+
+// ApproveNomination - Setujui pendaftaran dan masukkan sebagai candidate
+// @POST /elections/:id/nominations/:nomination_id/approve
+// Body: {ballot_number?: int}
+func (h *NominationHandler) ApproveNomination(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+
+	var req dto.ApproveNominationRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.NominationUsecase.ApproveNomination(ctx, electionID, nominationID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nomination approved successfully", res)
+}
+
+// RejectNomination - Tolak pendaftaran dengan alasan
+// @POST /elections/:id/nominations/:nomination_id/reject
+// Body: {reason: string}
+func (h *NominationHandler) RejectNomination(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+
+	var req dto.RejectNominationRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.NominationUsecase.RejectNomination(ctx, electionID, nominationID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Nomination rejected successfully", res)
+}
+
+// UploadDocument - Unggah dokumen pendukung pendaftaran
+// @POST /elections/:id/nominations/:nomination_id/documents
+// Form: file (multipart, PDF/JPEG/PNG)
+func (h *NominationHandler) UploadDocument(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Dokumen wajib diunggah", err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Gagal membaca dokumen", err)
+	}
+	defer file.Close()
+
+	res, sysErr := h.NominationUsecase.UploadDocument(ctx, electionID, nominationID, fileHeader.Filename, file)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Document uploaded successfully", res)
+}
+
+// DownloadDocument - Unduh isi dokumen pendaftaran
+// @GET /elections/:id/nominations/:nomination_id/documents/:document_id
+func (h *NominationHandler) DownloadDocument(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+	documentID, err := strconv.Atoi(ctx.Params("document_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid document ID", err)
+	}
+
+	res, sysErr := h.NominationUsecase.GetDocument(ctx, electionID, nominationID, documentID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	ctx.Set(fiber.HeaderContentType, res.ContentType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", res.FileName))
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	return ctx.Status(fiber.StatusOK).Send(res.Content)
+}
+
+// DeleteDocument - Hapus dokumen pendaftaran yang belum direview
+// @DELETE /elections/:id/nominations/:nomination_id/documents/:document_id
+func (h *NominationHandler) DeleteDocument(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	nominationID, err := strconv.Atoi(ctx.Params("nomination_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid nomination ID", err)
+	}
+	documentID, err := strconv.Atoi(ctx.Params("document_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid document ID", err)
+	}
+
+	if sysErr := h.NominationUsecase.DeleteDocument(ctx, electionID, nominationID, documentID); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Document deleted successfully", nil)
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/entity"
+)
+
+type NominationRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitNominationRepository(mainDB *database.MainDB) INominationRepository {
+	return &NominationRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *NominationRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type INominationRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetNominations(ctx fiber.Ctx, electionID int, status string) (res []entity.NominationDetail, sysError syserror.SysError)
+	GetNominationsByUser(ctx fiber.Ctx, electionID int, userID int) (res []entity.NominationDetail, sysError syserror.SysError)
+	GetNominationByID(ctx fiber.Ctx, electionID int, id int) (res entity.NominationDetail, sysError syserror.SysError)
+	GetNominationForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, sysError syserror.SysError)
+	CreateNomination(ctx fiber.Ctx, nomination entity.Nomination) (res entity.Nomination, sysError syserror.SysError)
+	UpdateNominationStatus(ctx fiber.Ctx, nomination entity.Nomination) (res entity.Nomination, sysError syserror.SysError)
+	HasApprovedNomination(ctx fiber.Ctx, electionID int, userID int) (exists bool, sysError syserror.SysError)
+
+	GetDocuments(ctx fiber.Ctx, nominationID int) (res []entity.NominationDocument, sysError syserror.SysError)
+	GetDocument(ctx fiber.Ctx, nominationID int, id int) (res entity.NominationDocument, sysError syserror.SysError)
+	CountDocuments(ctx fiber.Ctx, nominationID int) (count int, sysError syserror.SysError)
+	CreateDocument(ctx fiber.Ctx, document entity.NominationDocument) (res entity.NominationDocument, sysError syserror.SysError)
+	DeleteDocument(ctx fiber.Ctx, nominationID int, id int) (sysError syserror.SysError)
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/entity"
+)
+
+const nominationColumns = `id, election_id, contest_id, nominee_user_id, nominated_by, name, photo_url, vision, mission,
+	running_mate_name, running_mate_photo_url, status, review_reason, reviewed_by, reviewed_at, candidate_id, created_at, updated_at`
+
+const nominationDetailColumns = `n.id, n.election_id, n.contest_id, n.nominee_user_id, n.nominated_by, n.name, n.photo_url, n.vision, n.mission,
+	n.running_mate_name, n.running_mate_photo_url, n.status, n.review_reason, n.reviewed_by, n.reviewed_at, n.candidate_id,
+	n.created_at, n.updated_at, u.name AS nominee_name, u.email AS nominee_email`
+
+// documentColumns - tanpa content, isi file hanya diambil lewat GetDocument
+const documentColumns = `id, nomination_id, file_name, content_type, size, sha256, uploaded_by, created_at`
+
+// GetNominations - Semua pendaftaran election, status kosong berarti semua status
+func (r *NominationRepository) GetNominations(ctx fiber.Ctx, electionID int, status string) (res []entity.NominationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + nominationDetailColumns + `
+	          FROM public.candidate_nominations n
+	          JOIN public.users u ON u.id = n.nominee_user_id
+	          WHERE n.election_id = $1 AND ($2 = '' OR n.status = $2)
+	          ORDER BY n.id`
+
+	model := db.Select(&res, query, electionID, status)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil pendaftaran candidate")
+		return
+	}
+	return
+}
+
+// GetNominationsByUser - Pendaftaran di mana user adalah anggota yang didaftarkan atau yang mendaftarkan
+func (r *NominationRepository) GetNominationsByUser(ctx fiber.Ctx, electionID int, userID int) (res []entity.NominationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + nominationDetailColumns + `
+	          FROM public.candidate_nominations n
+	          JOIN public.users u ON u.id = n.nominee_user_id
+	          WHERE n.election_id = $1 AND (n.nominee_user_id = $2 OR n.nominated_by = $2)
+	          ORDER BY n.id`
+
+	model := db.Select(&res, query, electionID, userID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil pendaftaran candidate")
+		return
+	}
+	return
+}
+
+func (r *NominationRepository) GetNominationByID(ctx fiber.Ctx, electionID int, id int) (res entity.NominationDetail, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + nominationDetailColumns + `
+	          FROM public.candidate_nominations n
+	          JOIN public.users u ON u.id = n.nominee_user_id
+	          WHERE n.id = $1 AND n.election_id = $2`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Pendaftaran candidate tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil pendaftaran candidate")
+	}
+	return
+}
+
+// GetNominationForUpdate - Ambil pendaftaran sambil mengunci row-nya sampai transaction selesai
+func (r *NominationRepository) GetNominationForUpdate(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + nominationColumns + ` FROM public.candidate_nominations WHERE id = $1 AND election_id = $2 FOR UPDATE`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Pendaftaran candidate tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil pendaftaran candidate")
+	}
+	return
+}
+
+func (r *NominationRepository) CreateNomination(ctx fiber.Ctx, nomination entity.Nomination) (res entity.Nomination, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.candidate_nominations (election_id, contest_id, nominee_user_id, nominated_by, name, photo_url, vision, mission,
+	              running_mate_name, running_mate_photo_url, status, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12)
+	          RETURNING ` + nominationColumns
+
+	model := db.Get(&res, query, nomination.ElectionID, nomination.ContestID, nomination.NomineeUserID, nomination.NominatedBy,
+		nomination.Name, nomination.PhotoURL, nomination.Vision, nomination.Mission, nomination.RunningMateName,
+		nomination.RunningMatePhotoURL, entity.StatusPending, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Anggota sudah memiliki pendaftaran aktif pada contest ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat pendaftaran candidate")
+	}
+	return
+}
+
+// UpdateNominationStatus - Simpan status baru beserta data review (alasan, reviewer, candidate hasil persetujuan)
+func (r *NominationRepository) UpdateNominationStatus(ctx fiber.Ctx, nomination entity.Nomination) (res entity.Nomination, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.candidate_nominations
+	          SET status = $1, review_reason = $2, reviewed_by = $3, reviewed_at = $4, candidate_id = $5, updated_at = $6
+	          WHERE id = $7
+	          RETURNING ` + nominationColumns
+
+	model := db.Get(&res, query, nomination.Status, nomination.ReviewReason, nomination.ReviewedBy, nomination.ReviewedAt,
+		nomination.CandidateID, helper.Now(), nomination.ID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Pendaftaran candidate tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate pendaftaran candidate")
+	}
+	return
+}
+
+// HasApprovedNomination - Cek apakah user adalah candidate hasil pendaftaran yang disetujui pada election
+func (r *NominationRepository) HasApprovedNomination(ctx fiber.Ctx, electionID int, userID int) (exists bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.candidate_nominations
+	              WHERE election_id = $1 AND nominee_user_id = $2 AND status = 'approved'
+	          )`
+
+	model := db.Get(&exists, query, electionID, userID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil pendaftaran candidate")
+	}
+	return
+}
+
+func (r *NominationRepository) GetDocuments(ctx fiber.Ctx, nominationID int) (res []entity.NominationDocument, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + documentColumns + ` FROM public.nomination_documents WHERE nomination_id = $1 ORDER BY id`
+
+	model := db.Select(&res, query, nominationID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil dokumen pendaftaran")
+		return
+	}
+	return
+}
+
+// GetDocument - Satu dokumen beserta isi file-nya
+func (r *NominationRepository) GetDocument(ctx fiber.Ctx, nominationID int, id int) (res entity.NominationDocument, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + documentColumns + `, content FROM public.nomination_documents WHERE id = $1 AND nomination_id = $2`
+
+	model := db.Get(&res, query, id, nominationID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Dokumen tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil dokumen pendaftaran")
+	}
+	return
+}
+
+func (r *NominationRepository) CountDocuments(ctx fiber.Ctx, nominationID int) (count int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT COUNT(*) FROM public.nomination_documents WHERE nomination_id = $1`
+
+	model := db.Get(&count, query, nominationID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menghitung dokumen pendaftaran")
+	}
+	return
+}
+
+func (r *NominationRepository) CreateDocument(ctx fiber.Ctx, document entity.NominationDocument) (res entity.NominationDocument, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.nomination_documents (nomination_id, file_name, content_type, size, sha256, content, uploaded_by, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	          RETURNING ` + documentColumns
+
+	model := db.Get(&res, query, document.NominationID, document.FileName, document.ContentType, document.Size,
+		document.SHA256, document.Content, document.UploadedBy, helper.Now())
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan dokumen pendaftaran")
+	}
+	return
+}
+
+func (r *NominationRepository) DeleteDocument(ctx fiber.Ctx, nominationID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.nomination_documents WHERE id = $1 AND nomination_id = $2`
+
+	result, err := db.Exec(query, id, nominationID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus dokumen pendaftaran")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Dokumen tidak ditemukan")
+		return
+	}
+	return
+}
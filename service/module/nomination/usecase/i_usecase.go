@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	candidateUsecase "github.com/madmuzz05/be-enyoblos/service/module/candidate/usecase"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/repository"
+	notificationUsecase "github.com/madmuzz05/be-enyoblos/service/module/notification/usecase"
+	userUsecase "github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
+)
+
+type NominationUsecase struct {
+	nominationRepo  repository.INominationRepository
+	electionUse     electionUsecase.IElectionUsecase
+	candidateUse    candidateUsecase.ICandidateUsecase
+	userUse         userUsecase.IUserUsecase
+	notificationUse notificationUsecase.INotificationUsecase
+	mainDB          *dbpostgres.MainDB
+}
+
+func InitNominationUsecase(nominationRepo repository.INominationRepository, electionUse electionUsecase.IElectionUsecase, candidateUse candidateUsecase.ICandidateUsecase, userUse userUsecase.IUserUsecase, notificationUse notificationUsecase.INotificationUsecase, mainDB *dbpostgres.MainDB) INominationUsecase {
+	return &NominationUsecase{
+		nominationRepo:  nominationRepo,
+		electionUse:     electionUse,
+		candidateUse:    candidateUse,
+		userUse:         userUse,
+		notificationUse: notificationUse,
+		mainDB:          mainDB,
+	}
+}
+
+type INominationUsecase interface {
+	GetNominations(ctx fiber.Ctx, electionID int, status string) (res []entity.NominationDetail, sysError syserror.SysError)
+	GetMyNominations(ctx fiber.Ctx, electionID int) (res []entity.NominationDetail, sysError syserror.SysError)
+	GetNominationByID(ctx fiber.Ctx, electionID int, id int) (res entity.NominationDetail, sysError syserror.SysError)
+	CreateNomination(ctx fiber.Ctx, electionID int, req dto.CreateNominationRequest) (res entity.Nomination, sysError syserror.SysError)
+	WithdrawNomination(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, sysError syserror.SysError)
+	ApproveNomination(ctx fiber.Ctx, electionID int, id int, req dto.ApproveNominationRequest) (res entity.Nomination, sysError syserror.SysError)
+	RejectNomination(ctx fiber.Ctx, electionID int, id int, req dto.RejectNominationRequest) (res entity.Nomination, sysError syserror.SysError)
+	IsApprovedCandidate(ctx fiber.Ctx, electionID int, userID int) (approved bool, sysError syserror.SysError)
+
+	UploadDocument(ctx fiber.Ctx, electionID int, id int, fileName string, file io.Reader) (res entity.NominationDocument, sysError syserror.SysError)
+	GetDocument(ctx fiber.Ctx, electionID int, id int, documentID int) (res entity.NominationDocument, sysError syserror.SysError)
+	DeleteDocument(ctx fiber.Ctx, electionID int, id int, documentID int) (sysError syserror.SysError)
+}
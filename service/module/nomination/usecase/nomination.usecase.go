@@ -0,0 +1,489 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	candidateDTO "github.com/madmuzz05/be-enyoblos/service/module/candidate/dto"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/entity"
+	notificationEntity "github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+)
+
+const (
+	// maxDocumentSize - batas ukuran satu dokumen, di bawah batas body request default Fiber (4 MB)
+	maxDocumentSize = 2 << 20
+	// maxDocuments - batas jumlah dokumen pendukung per pendaftaran
+	maxDocuments = 10
+)
+
+// allowedDocumentTypes - jenis dokumen pendukung yang diterima, dideteksi dari isi file
+var allowedDocumentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// GetNominations - Daftar pendaftaran candidate election untuk direview panitia (admin organization)
+func (u *NominationUsecase) GetNominations(ctx fiber.Ctx, electionID int, status string) (res []entity.NominationDetail, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.nominationRepo.GetNominations(ctx, electionID, status)
+	return
+}
+
+// GetMyNominations - Pendaftaran milik user yang login, baik yang mendaftar sendiri maupun yang didaftarkan/mendaftarkan
+func (u *NominationUsecase) GetMyNominations(ctx fiber.Ctx, electionID int) (res []entity.NominationDetail, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.nominationRepo.GetNominationsByUser(ctx, electionID, userID)
+	return
+}
+
+// GetNominationByID - Detail pendaftaran beserta daftar dokumennya (pihak pendaftaran atau admin organization)
+func (u *NominationUsecase) GetNominationByID(ctx fiber.Ctx, electionID int, id int) (res entity.NominationDetail, sysError syserror.SysError) {
+	res, sysError = u.getAccessibleNomination(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	res.Documents, sysError = u.nominationRepo.GetDocuments(ctx, id)
+	return
+}
+
+// CreateNomination - Anggota organization mendaftarkan diri sendiri atau anggota lain sebagai candidate,
+// hanya selama masa pendaftaran dan election masih draft
+func (u *NominationUsecase) CreateNomination(ctx fiber.Ctx, electionID int, req dto.CreateNominationRequest) (res entity.Nomination, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetElectionByIDForShare(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Type == electionEntity.TypeReferendum {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election referendum tidak menerima pendaftaran candidate")
+		return
+	}
+
+	open, sysError := u.electionUse.IsNominationOpen(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if !open || election.Status != electionEntity.StatusDraft {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Masa pendaftaran candidate sedang tidak dibuka")
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	nominator, sysError := u.userUse.GetUserByID(ctx, strconv.Itoa(userID))
+	if sysError != nil {
+		return
+	}
+	if nominator.OrganizationID != election.OrganizationID {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya anggota organization penyelenggara yang dapat mendaftarkan candidate")
+		return
+	}
+
+	nominee := nominator
+	if req.NomineeUserID != 0 && req.NomineeUserID != userID {
+		if nominee, sysError = u.userUse.GetUserByID(ctx, strconv.Itoa(req.NomineeUserID)); sysError != nil {
+			return
+		}
+		if nominee.OrganizationID != election.OrganizationID {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Candidate harus anggota organization penyelenggara")
+			return
+		}
+	}
+
+	contestID, sysError := u.candidateUse.ResolveContestID(ctx, electionID, req.ContestID)
+	if sysError != nil {
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = nominee.Name
+	}
+
+	res, sysError = u.nominationRepo.CreateNomination(ctx, entity.Nomination{
+		ElectionID:          electionID,
+		ContestID:           contestID,
+		NomineeUserID:       nominee.ID,
+		NominatedBy:         &userID,
+		Name:                name,
+		PhotoURL:            req.PhotoURL,
+		Vision:              req.Vision,
+		Mission:             req.Mission,
+		RunningMateName:     req.RunningMateName,
+		RunningMatePhotoURL: req.RunningMatePhotoURL,
+	})
+	if sysError != nil {
+		return
+	}
+
+	if nominee.ID != userID {
+		sysError = u.notifyNominee(ctx, election, res, notificationEntity.TypeNominationSubmitted,
+			"Anda didaftarkan sebagai candidate",
+			fmt.Sprintf("%s mendaftarkan Anda sebagai candidate pada %s, pendaftaran menunggu persetujuan panitia", nominator.Name, election.Title))
+	}
+	return
+}
+
+// WithdrawNomination - Batalkan pendaftaran yang belum direview, oleh anggota yang didaftarkan atau yang mendaftarkan
+func (u *NominationUsecase) WithdrawNomination(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	nomination, userID, sysError := u.getEditableNomination(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	nomination.Status = entity.StatusWithdrawn
+	res, sysError = u.nominationRepo.UpdateNominationStatus(ctx, nomination)
+	if sysError != nil {
+		return
+	}
+
+	if res.NomineeUserID != userID {
+		sysError = u.notifyNominee(ctx, election, res, notificationEntity.TypeNominationWithdrawn,
+			"Pendaftaran candidate dibatalkan",
+			fmt.Sprintf("Pendaftaran Anda sebagai candidate pada %s dibatalkan oleh anggota yang mendaftarkan", election.Title))
+	}
+	return
+}
+
+// ApproveNomination - Panitia menyetujui pendaftaran, candidate dibuat pada contest yang dipilih
+// sehingga tampil di surat suara. Hanya saat election masih draft
+func (u *NominationUsecase) ApproveNomination(ctx fiber.Ctx, electionID int, id int, req dto.ApproveNominationRequest) (res entity.Nomination, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	nomination, election, reviewerID, sysError := u.getReviewableNomination(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	candidate, sysError := u.candidateUse.CreateNominatedCandidate(ctx, electionID, candidateDTO.CreateCandidateRequest{
+		ContestID:           nomination.ContestID,
+		BallotNumber:        req.BallotNumber,
+		Name:                nomination.Name,
+		PhotoURL:            nomination.PhotoURL,
+		Vision:              nomination.Vision,
+		Mission:             nomination.Mission,
+		RunningMateName:     nomination.RunningMateName,
+		RunningMatePhotoURL: nomination.RunningMatePhotoURL,
+	})
+	if sysError != nil {
+		return
+	}
+
+	reviewedAt := helper.Now()
+	nomination.Status = entity.StatusApproved
+	nomination.ReviewedBy = &reviewerID
+	nomination.ReviewedAt = &reviewedAt
+	nomination.CandidateID = &candidate.ID
+	res, sysError = u.nominationRepo.UpdateNominationStatus(ctx, nomination)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.notifyNominee(ctx, election, res, notificationEntity.TypeNominationApproved,
+		"Pendaftaran candidate disetujui",
+		fmt.Sprintf("Pendaftaran Anda sebagai candidate pada %s disetujui panitia dengan nomor urut %d", election.Title, candidate.BallotNumber))
+	return
+}
+
+// RejectNomination - Panitia menolak pendaftaran dengan alasan yang dikirim ke anggota yang didaftarkan
+func (u *NominationUsecase) RejectNomination(ctx fiber.Ctx, electionID int, id int, req dto.RejectNominationRequest) (res entity.Nomination, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Alasan penolakan wajib diisi")
+		return
+	}
+
+	nomination, election, reviewerID, sysError := u.getReviewableNomination(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	reviewedAt := helper.Now()
+	nomination.Status = entity.StatusRejected
+	nomination.ReviewReason = &reason
+	nomination.ReviewedBy = &reviewerID
+	nomination.ReviewedAt = &reviewedAt
+	res, sysError = u.nominationRepo.UpdateNominationStatus(ctx, nomination)
+	if sysError != nil {
+		return
+	}
+
+	sysError = u.notifyNominee(ctx, election, res, notificationEntity.TypeNominationRejected,
+		"Pendaftaran candidate ditolak",
+		fmt.Sprintf("Pendaftaran Anda sebagai candidate pada %s ditolak panitia: %s", election.Title, reason))
+	return
+}
+
+// IsApprovedCandidate - Cek apakah user menjadi candidate election lewat pendaftaran yang disetujui
+func (u *NominationUsecase) IsApprovedCandidate(ctx fiber.Ctx, electionID int, userID int) (approved bool, sysError syserror.SysError) {
+	approved, sysError = u.nominationRepo.HasApprovedNomination(ctx, electionID, userID)
+	return
+}
+
+// UploadDocument - Unggah dokumen pendukung (PDF, JPEG atau PNG) selama pendaftaran belum direview
+func (u *NominationUsecase) UploadDocument(ctx fiber.Ctx, electionID int, id int, fileName string, file io.Reader) (res entity.NominationDocument, sysError syserror.SysError) {
+	// file dibaca sebelum transaction dibuka supaya lock pendaftaran tidak tertahan selama upload
+	content, contentType, sysError := readDocument(file)
+	if sysError != nil {
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	nomination, userID, sysError := u.getEditableNomination(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+
+	count, sysError := u.nominationRepo.CountDocuments(ctx, nomination.ID)
+	if sysError != nil {
+		return
+	}
+	if count >= maxDocuments {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, fmt.Sprintf("Maksimal %d dokumen per pendaftaran", maxDocuments))
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	res, sysError = u.nominationRepo.CreateDocument(ctx, entity.NominationDocument{
+		NominationID: nomination.ID,
+		FileName:     helper.UploadFileName(fileName),
+		ContentType:  contentType,
+		Size:         len(content),
+		SHA256:       hex.EncodeToString(sum[:]),
+		Content:      content,
+		UploadedBy:   &userID,
+	})
+	return
+}
+
+// GetDocument - Unduh dokumen pendaftaran (pihak pendaftaran atau admin organization)
+func (u *NominationUsecase) GetDocument(ctx fiber.Ctx, electionID int, id int, documentID int) (res entity.NominationDocument, sysError syserror.SysError) {
+	if _, sysError = u.getAccessibleNomination(ctx, electionID, id); sysError != nil {
+		return
+	}
+
+	res, sysError = u.nominationRepo.GetDocument(ctx, id, documentID)
+	return
+}
+
+// DeleteDocument - Hapus dokumen selama pendaftaran belum direview
+func (u *NominationUsecase) DeleteDocument(ctx fiber.Ctx, electionID int, id int, documentID int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, _, sysError = u.getEditableNomination(ctx, electionID, id); sysError != nil {
+		return
+	}
+
+	sysError = u.nominationRepo.DeleteDocument(ctx, id, documentID)
+	return
+}
+
+// getAccessibleNomination - Pendaftaran hanya bisa dilihat anggota yang didaftarkan, yang mendaftarkan, atau admin organization
+func (u *NominationUsecase) getAccessibleNomination(ctx fiber.Ctx, electionID int, id int) (res entity.NominationDetail, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.nominationRepo.GetNominationByID(ctx, electionID, id)
+	if sysError != nil || isParty(res.Nomination, userID) {
+		return
+	}
+
+	_, sysError = u.electionUse.GetManagedElection(ctx, electionID)
+	return
+}
+
+// getEditableNomination - Kunci pendaftaran yang masih menunggu review dan pastikan user yang login adalah pihak pendaftaran.
+// Harus dipanggil di dalam transaction
+func (u *NominationUsecase) getEditableNomination(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, userID int, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.nominationRepo.GetNominationForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if !isParty(res, userID) {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Hanya anggota yang didaftarkan atau yang mendaftarkan yang dapat mengubah pendaftaran ini")
+		return
+	}
+	if res.Status != entity.StatusPending {
+		sysError = errAlreadyReviewed()
+	}
+	return
+}
+
+// getReviewableNomination - Kunci election (harus draft) dan pendaftaran yang masih menunggu review oleh admin organization.
+// Harus dipanggil di dalam transaction
+func (u *NominationUsecase) getReviewableNomination(ctx fiber.Ctx, electionID int, id int) (res entity.Nomination, election electionEntity.Election, reviewerID int, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusDraft {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Pendaftaran candidate hanya dapat direview saat election berstatus draft")
+		return
+	}
+
+	reviewerID, _ = middleware.GetUserID(ctx)
+
+	res, sysError = u.nominationRepo.GetNominationForUpdate(ctx, electionID, id)
+	if sysError != nil {
+		return
+	}
+	if res.Status != entity.StatusPending {
+		sysError = errAlreadyReviewed()
+	}
+	return
+}
+
+// notifyNominee - Kirim notifikasi perubahan status pendaftaran ke anggota yang didaftarkan
+func (u *NominationUsecase) notifyNominee(ctx fiber.Ctx, election electionEntity.Election, nomination entity.Nomination, notificationType, title, message string) syserror.SysError {
+	return u.notificationUse.Notify(ctx, notificationEntity.Notification{
+		UserID:      nomination.NomineeUserID,
+		Type:        notificationType,
+		Title:       title,
+		Message:     message,
+		ElectionID:  &election.ID,
+		ReferenceID: &nomination.ID,
+	})
+}
+
+// isParty - user adalah anggota yang didaftarkan atau yang mendaftarkan
+func isParty(nomination entity.Nomination, userID int) bool {
+	return nomination.NomineeUserID == userID || (nomination.NominatedBy != nil && *nomination.NominatedBy == userID)
+}
+
+// readDocument - Baca dokumen unggahan dan terjemahkan kegagalannya ke error API
+func readDocument(file io.Reader) (content []byte, contentType string, sysError syserror.SysError) {
+	content, contentType, err := helper.ReadUpload(file, maxDocumentSize, allowedDocumentTypes)
+	switch {
+	case errors.Is(err, helper.ErrUploadEmpty):
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Dokumen tidak boleh kosong")
+	case errors.Is(err, helper.ErrUploadTooLarge):
+		sysError = syserror.CreateError(err, fiber.StatusRequestEntityTooLarge, fmt.Sprintf("Ukuran dokumen maksimal %d MB", maxDocumentSize>>20))
+	case errors.Is(err, helper.ErrUploadUnsupported):
+		sysError = syserror.CreateError(err, fiber.StatusUnsupportedMediaType, "Dokumen harus berupa PDF, JPEG atau PNG")
+	case err != nil:
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Gagal membaca dokumen")
+	}
+	return
+}
+
+func errAlreadyReviewed() syserror.SysError {
+	return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Pendaftaran candidate sudah diproses dan tidak dapat diubah")
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	TypeNominationSubmitted = "nomination_submitted" // user didaftarkan sebagai candidate oleh anggota lain
+	TypeNominationApproved  = "nomination_approved"
+	TypeNominationRejected  = "nomination_rejected"
+	TypeNominationWithdrawn = "nomination_withdrawn"
+	TypeDisputeResponded    = "dispute_responded" // panitia menanggapi sanggahan
+	TypeDisputeResolved     = "dispute_resolved"  // sanggahan diselesaikan atau ditolak panitia
+)
+
+// Notification - Notifikasi in-app untuk satu user. ReferenceID menunjuk data sesuai Type (misal id pendaftaran)
+type Notification struct {
+	ID          int                `db:"id" json:"id"`
+	UserID      int                `db:"user_id" json:"user_id"`
+	Type        string             `db:"type" json:"type"`
+	Title       string             `db:"title" json:"title"`
+	Message     string             `db:"message" json:"message"`
+	ElectionID  *int               `db:"election_id" json:"election_id"`
+	ReferenceID *int               `db:"reference_id" json:"reference_id"`
+	ReadAt      *helper.CustomTime `db:"read_at" json:"read_at"`
+	CreatedAt   helper.CustomTime  `db:"created_at" json:"created_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
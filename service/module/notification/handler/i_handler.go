@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/notification/usecase"
+
+type NotificationHandler struct {
+	NotificationUsecase usecase.INotificationUsecase
+}
+
+func InitNotificationHandler(notificationUsecase usecase.INotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		NotificationUsecase: notificationUsecase,
+	}
+}
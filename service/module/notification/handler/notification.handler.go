@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// GetMyNotifications - Notifikasi milik user yang login, ?unread=true untuk yang belum dibaca saja
+// @GET /notifications/me?unread=
+func (h *NotificationHandler) GetMyNotifications(ctx fiber.Ctx) error {
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	unreadOnly, _ := strconv.ParseBool(ctx.Query("unread"))
+
+	res, totalRecords, sysErr := h.NotificationUsecase.GetMyNotifications(ctx, unreadOnly)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendPaginatedResponse(ctx, fiber.StatusOK, "Notifications retrieved successfully",
+		pagination.Page, pagination.PageSize, totalRecords, res)
+}
+
+// MarkAsRead - Tandai notifikasi sudah dibaca
+// @PATCH /notifications/:notification_id/read
+func (h *NotificationHandler) MarkAsRead(ctx fiber.Ctx) error {
+	id, err := strconv.Atoi(ctx.Params("notification_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid notification ID", err)
+	}
+
+	res, sysErr := h.NotificationUsecase.MarkAsRead(ctx, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Notification marked as read", res)
+}
+
+// MarkAllAsRead - Tandai semua notifikasi user sudah dibaca
+// @PATCH /notifications/me/read
+func (h *NotificationHandler) MarkAllAsRead(ctx fiber.Ctx) error {
+	if sysErr := h.NotificationUsecase.MarkAllAsRead(ctx); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Notifications marked as read", nil)
+}
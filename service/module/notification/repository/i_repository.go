@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+)
+
+type NotificationRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitNotificationRepository(mainDB *database.MainDB) INotificationRepository {
+	return &NotificationRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *NotificationRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type INotificationRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetNotifications(ctx fiber.Ctx, userID int, unreadOnly bool) (res []entity.Notification, totalRecords int64, sysError syserror.SysError)
+	CreateNotification(ctx fiber.Ctx, notification entity.Notification) (res entity.Notification, sysError syserror.SysError)
+	MarkAsRead(ctx fiber.Ctx, userID int, id int) (res entity.Notification, sysError syserror.SysError)
+	MarkAllAsRead(ctx fiber.Ctx, userID int) (sysError syserror.SysError)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+)
+
+const notificationColumns = `id, user_id, type, title, message, election_id, reference_id, read_at, created_at`
+
+// GetNotifications - Notifikasi milik user, terbaru lebih dulu
+func (r *NotificationRepository) GetNotifications(ctx fiber.Ctx, userID int, unreadOnly bool) (res []entity.Notification, totalRecords int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	offset := helper.GetOffset(pagination.Page, pagination.PageSize)
+
+	filter := ` WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)`
+
+	countQuery := `SELECT COUNT(*) FROM public.notifications` + filter
+	model := db.Get(&totalRecords, countQuery, userID, unreadOnly)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil total records")
+		return
+	}
+
+	query := `SELECT ` + notificationColumns + ` FROM public.notifications` + filter +
+		` ORDER BY id DESC LIMIT $3 OFFSET $4`
+	model = db.Select(&res, query, userID, unreadOnly, pagination.PageSize, offset)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil notifikasi")
+		return
+	}
+	return
+}
+
+func (r *NotificationRepository) CreateNotification(ctx fiber.Ctx, notification entity.Notification) (res entity.Notification, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.notifications (user_id, type, title, message, election_id, reference_id, created_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7)
+	          RETURNING ` + notificationColumns
+
+	model := db.Get(&res, query, notification.UserID, notification.Type, notification.Title, notification.Message,
+		notification.ElectionID, notification.ReferenceID, helper.Now())
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat notifikasi")
+	}
+	return
+}
+
+// MarkAsRead - Tandai satu notifikasi milik user sudah dibaca, waktu baca pertama dipertahankan
+func (r *NotificationRepository) MarkAsRead(ctx fiber.Ctx, userID int, id int) (res entity.Notification, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.notifications SET read_at = COALESCE(read_at, $1)
+	          WHERE id = $2 AND user_id = $3
+	          RETURNING ` + notificationColumns
+
+	model := db.Get(&res, query, helper.Now(), id, userID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Notifikasi tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate notifikasi")
+	}
+	return
+}
+
+func (r *NotificationRepository) MarkAllAsRead(ctx fiber.Ctx, userID int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL`
+
+	if _, err := db.Exec(query, helper.Now(), userID); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengupdate notifikasi")
+	}
+	return
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/repository"
+)
+
+type NotificationUsecase struct {
+	notificationRepo repository.INotificationRepository
+}
+
+func InitNotificationUsecase(notificationRepo repository.INotificationRepository) INotificationUsecase {
+	return &NotificationUsecase{
+		notificationRepo: notificationRepo,
+	}
+}
+
+type INotificationUsecase interface {
+	GetMyNotifications(ctx fiber.Ctx, unreadOnly bool) (res []entity.Notification, totalRecords int64, sysError syserror.SysError)
+	MarkAsRead(ctx fiber.Ctx, id int) (res entity.Notification, sysError syserror.SysError)
+	MarkAllAsRead(ctx fiber.Ctx) (sysError syserror.SysError)
+	Notify(ctx fiber.Ctx, notification entity.Notification) (sysError syserror.SysError)
+}
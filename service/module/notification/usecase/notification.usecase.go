@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/entity"
+)
+
+// GetMyNotifications - Notifikasi milik user yang login
+func (u *NotificationUsecase) GetMyNotifications(ctx fiber.Ctx, unreadOnly bool) (res []entity.Notification, totalRecords int64, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, totalRecords, sysError = u.notificationRepo.GetNotifications(ctx, userID, unreadOnly)
+	return
+}
+
+func (u *NotificationUsecase) MarkAsRead(ctx fiber.Ctx, id int) (res entity.Notification, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	res, sysError = u.notificationRepo.MarkAsRead(ctx, userID, id)
+	return
+}
+
+func (u *NotificationUsecase) MarkAllAsRead(ctx fiber.Ctx) (sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	sysError = u.notificationRepo.MarkAllAsRead(ctx, userID)
+	return
+}
+
+// Notify - Simpan notifikasi untuk user. Tidak membuka transaction sendiri supaya notifikasi
+// ikut batal jika perubahan yang memicunya dibatalkan
+func (u *NotificationUsecase) Notify(ctx fiber.Ctx, notification entity.Notification) (sysError syserror.SysError) {
+	_, sysError = u.notificationRepo.CreateNotification(ctx, notification)
+	return
+}
@@ -0,0 +1,6 @@
+package dto
+
+// AddObserverRequest - DTO untuk menunjuk user sebagai saksi election
+type AddObserverRequest struct {
+	UserID int `json:"user_id" validate:"required,gt=0"`
+}
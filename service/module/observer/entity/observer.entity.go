@@ -0,0 +1,16 @@
+package entity
+
+// Observer - Saksi election, disimpan sebagai role observer di users_has_roles yang terikat ke satu election.
+// Saksi hanya bisa memantau turnout, riwayat status dan rantai hash tanpa melihat identitas pemilih
+type Observer struct {
+	ID             int    `db:"id" json:"id"`
+	ElectionID     int    `db:"election_id" json:"election_id"`
+	UserID         int    `db:"user_id" json:"user_id"`
+	OrganizationID int    `db:"organization_id" json:"organization_id"`
+	Name           string `db:"name" json:"name"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (Observer) TableName() string {
+	return "users_has_roles"
+}
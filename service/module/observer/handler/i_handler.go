@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/observer/usecase"
+
+type ObserverHandler struct {
+	ObserverUsecase usecase.IObserverUsecase
+}
+
+func InitObserverHandler(observerUsecase usecase.IObserverUsecase) *ObserverHandler {
+	return &ObserverHandler{
+		ObserverUsecase: observerUsecase,
+	}
+}
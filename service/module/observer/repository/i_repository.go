@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/entity"
+)
+
+type ObserverRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitObserverRepository(mainDB *database.MainDB) IObserverRepository {
+	return &ObserverRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *ObserverRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IObserverRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetObservers(ctx fiber.Ctx, electionID int) (res []entity.Observer, sysError syserror.SysError)
+	CreateObserver(ctx fiber.Ctx, observer entity.Observer) (res entity.Observer, sysError syserror.SysError)
+	DeleteObserver(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
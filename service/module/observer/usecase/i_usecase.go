@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/repository"
+	userUsecase "github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
+)
+
+type ObserverUsecase struct {
+	observerRepo repository.IObserverRepository
+	electionUse  electionUsecase.IElectionUsecase
+	userUse      userUsecase.IUserUsecase
+	mainDB       *dbpostgres.MainDB
+}
+
+func InitObserverUsecase(observerRepo repository.IObserverRepository, electionUse electionUsecase.IElectionUsecase, userUse userUsecase.IUserUsecase, mainDB *dbpostgres.MainDB) IObserverUsecase {
+	return &ObserverUsecase{
+		observerRepo: observerRepo,
+		electionUse:  electionUse,
+		userUse:      userUse,
+		mainDB:       mainDB,
+	}
+}
+
+type IObserverUsecase interface {
+	GetObservers(ctx fiber.Ctx, electionID int) (res []entity.Observer, sysError syserror.SysError)
+	AddObserver(ctx fiber.Ctx, electionID int, req dto.AddObserverRequest) (res entity.Observer, sysError syserror.SysError)
+	DeleteObserver(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+}
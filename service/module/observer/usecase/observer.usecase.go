@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/entity"
+)
+
+// GetObservers - Daftar saksi election (admin organization)
+func (u *ObserverUsecase) GetObservers(ctx fiber.Ctx, electionID int) (res []entity.Observer, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.observerRepo.GetObservers(ctx, electionID)
+	return
+}
+
+// AddObserver - Tunjuk user sebagai saksi election. Saksi boleh dari luar organization
+// penyelenggara (misal utusan kandidat), role-nya tetap dicatat atas organization penyelenggara
+func (u *ObserverUsecase) AddObserver(ctx fiber.Ctx, electionID int, req dto.AddObserverRequest) (res entity.Observer, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	if _, sysError = u.userUse.GetUserByID(ctx, strconv.Itoa(req.UserID)); sysError != nil {
+		return
+	}
+
+	res, sysError = u.observerRepo.CreateObserver(ctx, entity.Observer{
+		ElectionID:     electionID,
+		UserID:         req.UserID,
+		OrganizationID: election.OrganizationID,
+	})
+	return
+}
+
+// DeleteObserver - Cabut penunjukan saksi election (admin organization)
+func (u *ObserverUsecase) DeleteObserver(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	sysError = u.observerRepo.DeleteObserver(ctx, electionID, id)
+	return
+}
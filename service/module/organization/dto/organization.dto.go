@@ -7,16 +7,19 @@ type PaginatedOrganizations struct {
 	TotalRecords int64                 `json:"total_records"`
 }
 
-// CreateOrganizationRequest - DTO untuk create organization
+// CreateOrganizationRequest - DTO untuk create organization, parent_id diisi untuk organization turunan
 type CreateOrganizationRequest struct {
 	Name      string `json:"name" validate:"required"`
 	ShortName string `json:"short_name" validate:"required"`
 	Address   string `json:"address"`
+	ParentID  *int   `json:"parent_id" validate:"omitempty,gt=0"`
 }
 
-// UpdateOrganizationRequest - DTO untuk update organization
+// UpdateOrganizationRequest - DTO untuk update organization. parent_id kosong berarti induk tidak diubah,
+// 0 berarti organization dilepas dari induknya
 type UpdateOrganizationRequest struct {
 	Name      string `json:"name" validate:"required"`
 	ShortName string `json:"short_name" validate:"required"`
 	Address   string `json:"address"`
+	ParentID  *int   `json:"parent_id" validate:"omitempty,gte=0"`
 }
@@ -5,6 +5,7 @@ type Organization struct {
 	Name      string `db:"name" json:"name"`
 	ShortName string `db:"short_name" json:"short_name"`
 	Address   string `db:"address" json:"address,omitempty"`
+	ParentID  *int   `db:"parent_id" json:"parent_id"` // organization induk, kosong untuk organization tingkat teratas
 }
 
 func (Organization) TableName() string {
@@ -66,8 +66,8 @@ func (r *OrganizationRepository) CreateOrganization(ctx fiber.Ctx, req dto.Creat
 		Address:   req.Address,
 	}
 
-	query := `INSERT INTO public.organizations (name, short_name, address) VALUES ($1, $2, $3) RETURNING *`
-	model := db.Get(&res, query, res.Name, res.ShortName, res.Address)
+	query := `INSERT INTO public.organizations (name, short_name, address, parent_id) VALUES ($1, $2, $3, $4) RETURNING *`
+	model := db.Get(&res, query, res.Name, res.ShortName, res.Address, req.ParentID)
 
 	if model != nil {
 		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat organization")
@@ -98,8 +98,11 @@ func (r *OrganizationRepository) UpdateOrganization(ctx fiber.Ctx, id int, req d
 		res.Address = req.Address
 	}
 
-	query := `UPDATE public.organizations SET name = $1, short_name = $2, address = $3 WHERE id = $4 RETURNING *`
-	model := db.Get(&res, query, res.Name, res.ShortName, res.Address, id)
+	query := `UPDATE public.organizations
+	          SET name = $1, short_name = $2, address = $3,
+	              parent_id = CASE WHEN $5::int IS NULL THEN parent_id ELSE NULLIF($5::int, 0) END
+	          WHERE id = $4 RETURNING *`
+	model := db.Get(&res, query, res.Name, res.ShortName, res.Address, id, req.ParentID)
 
 	if model != nil {
 		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengupdate organization")
@@ -112,6 +115,28 @@ func (r *OrganizationRepository) UpdateOrganization(ctx fiber.Ctx, id int, req d
 	return
 }
 
+// GetOrganizationTree - id organization beserta seluruh organization turunannya
+func (r *OrganizationRepository) GetOrganizationTree(ctx fiber.Ctx, id int) (res []int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH RECURSIVE tree AS (
+	              SELECT id FROM public.organizations WHERE id = $1
+	              UNION
+	              SELECT o.id FROM public.organizations o JOIN tree t ON o.parent_id = t.id
+	          )
+	          SELECT id FROM tree ORDER BY id`
+
+	model := db.Select(&res, query, id)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil organization turunan")
+		return
+	}
+	return
+}
+
 // DeleteOrganization - Delete organization
 func (r *OrganizationRepository) DeleteOrganization(ctx fiber.Ctx, id int) (sysError syserror.SysError) {
 	db := database.DBWithCtx{
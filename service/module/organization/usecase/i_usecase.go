@@ -30,4 +30,5 @@ type IOrganizationUsecase interface {
 	CreateOrganization(ctx fiber.Ctx, req dto.CreateOrganizationRequest) (res entity.Organization, sysError syserror.SysError)
 	UpdateOrganization(ctx fiber.Ctx, id int, req dto.UpdateOrganizationRequest) (res entity.Organization, sysError syserror.SysError)
 	DeleteOrganization(ctx fiber.Ctx, id int) (sysError syserror.SysError)
+	GetOrganizationTree(ctx fiber.Ctx, id int) (res []int, sysError syserror.SysError)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/gofiber/fiber/v3"
 	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
@@ -28,7 +29,7 @@ func (u *OrganizationUsecase) CreateOrganization(ctx fiber.Ctx, req dto.CreateOr
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -36,6 +37,12 @@ func (u *OrganizationUsecase) CreateOrganization(ctx fiber.Ctx, req dto.CreateOr
 		return
 	}
 
+	if req.ParentID != nil {
+		if _, sysError = u.organizationRepo.GetOrganizationByID(ctx, *req.ParentID); sysError != nil {
+			return
+		}
+	}
+
 	res, sysError = u.organizationRepo.CreateOrganization(ctx, req)
 	return
 }
@@ -48,7 +55,7 @@ func (u *OrganizationUsecase) UpdateOrganization(ctx fiber.Ctx, id int, req dto.
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -62,11 +69,34 @@ func (u *OrganizationUsecase) UpdateOrganization(ctx fiber.Ctx, id int, req dto.
 		return
 	}
 
+	if req.ParentID != nil && *req.ParentID != 0 {
+		if _, sysError = u.organizationRepo.GetOrganizationByID(ctx, *req.ParentID); sysError != nil {
+			return
+		}
+
+		// induk tidak boleh organization itu sendiri atau turunannya supaya hirarki tidak berputar
+		tree, errTree := u.organizationRepo.GetOrganizationTree(ctx, id)
+		if errTree != nil {
+			sysError = errTree
+			return
+		}
+		if slices.Contains(tree, *req.ParentID) {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "parent_id tidak boleh organization itu sendiri atau turunannya")
+			return
+		}
+	}
+
 	// Update organization
 	res, sysError = u.organizationRepo.UpdateOrganization(ctx, id, req)
 	return
 }
 
+// GetOrganizationTree - id organization beserta seluruh organization turunannya
+func (u *OrganizationUsecase) GetOrganizationTree(ctx fiber.Ctx, id int) (res []int, sysError syserror.SysError) {
+	res, sysError = u.organizationRepo.GetOrganizationTree(ctx, id)
+	return
+}
+
 // DeleteOrganization - Delete organization
 func (u *OrganizationUsecase) DeleteOrganization(ctx fiber.Ctx, id int) (sysError syserror.SysError) {
 	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
@@ -75,7 +105,7 @@ func (u *OrganizationUsecase) DeleteOrganization(ctx fiber.Ctx, id int) (sysErro
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
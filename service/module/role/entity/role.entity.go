@@ -1,5 +1,19 @@
 package entity
 
+const (
+	RoleAdmin      = "admin"
+	RoleSuperadmin = "superadmin"
+	RoleObserver   = "observer"    // saksi, hanya berlaku pada election tertentu
+	RolePollWorker = "poll_worker" // petugas TPS, hanya berlaku pada election tertentu
+	RoleUser       = "user"        // role token untuk user tanpa role apa pun
+)
+
 type Role struct {
-	ID string `json:"id"`
+	ID          int    `db:"id" json:"id"`
+	Name        string `db:"name" json:"name"`
+	Description string `db:"description" json:"description,omitempty"`
+}
+
+func (Role) TableName() string {
+	return "roles"
 }
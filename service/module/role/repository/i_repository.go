@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+)
+
+type RoleRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitRoleRepository(mainDB *database.MainDB) IRoleRepository {
+	return &RoleRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *RoleRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IRoleRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	HasRole(ctx fiber.Ctx, userID int, organizationID int, roleNames []string) (exists bool, sysError syserror.SysError)
+	HasElectionRole(ctx fiber.Ctx, userID int, electionID int, roleNames []string) (exists bool, sysError syserror.SysError)
+	GetRoleNames(ctx fiber.Ctx, userID int) (res []string, sysError syserror.SysError)
+}
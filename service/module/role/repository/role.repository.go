@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+)
+
+// HasRole - Cek apakah user memiliki salah satu role pada organization tertentu
+// organizationID = 0 berarti role berlaku di organization mana pun.
+// Role yang terikat ke satu election tidak ikut dihitung
+func (r *RoleRepository) HasRole(ctx fiber.Ctx, userID int, organizationID int, roleNames []string) (exists bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.users_has_roles uhr
+	              JOIN public.roles r ON r.id = uhr.role_id
+	              WHERE uhr.user_id = $1
+	                AND r.name = ANY($2)
+	                AND ($3 = 0 OR uhr.organization_id = $3)
+	                AND uhr.election_id IS NULL
+	          )`
+
+	model := db.Get(&exists, query, userID, pq.Array(roleNames), organizationID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil role user")
+		return
+	}
+	return
+}
+
+// HasElectionRole - Cek apakah user memiliki salah satu role yang terikat ke election tertentu
+func (r *RoleRepository) HasElectionRole(ctx fiber.Ctx, userID int, electionID int, roleNames []string) (exists bool, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM public.users_has_roles uhr
+	              JOIN public.roles r ON r.id = uhr.role_id
+	              WHERE uhr.user_id = $1
+	                AND r.name = ANY($2)
+	                AND uhr.election_id = $3
+	          )`
+
+	model := db.Get(&exists, query, userID, pq.Array(roleNames), electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil role user")
+		return
+	}
+	return
+}
+
+// GetRoleNames - Semua nama role yang dimiliki user, baik organization maupun election
+func (r *RoleRepository) GetRoleNames(ctx fiber.Ctx, userID int) (res []string, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT DISTINCT r.name FROM public.users_has_roles uhr
+	          JOIN public.roles r ON r.id = uhr.role_id
+	          WHERE uhr.user_id = $1`
+
+	model := db.Select(&res, query, userID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil role user")
+		return
+	}
+	return
+}
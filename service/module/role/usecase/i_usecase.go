@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/role/repository"
+)
+
+type RoleUsecase struct {
+	roleRepo repository.IRoleRepository
+}
+
+func InitRoleUsecase(roleRepo repository.IRoleRepository) IRoleUsecase {
+	return &RoleUsecase{
+		roleRepo: roleRepo,
+	}
+}
+
+type IRoleUsecase interface {
+	IsOrganizationAdmin(ctx fiber.Ctx, userID int, organizationID int) (isAdmin bool, sysError syserror.SysError)
+	IsElectionObserver(ctx fiber.Ctx, userID int, electionID int) (isObserver bool, sysError syserror.SysError)
+	IsPollWorker(ctx fiber.Ctx, userID int, electionID int) (isPollWorker bool, sysError syserror.SysError)
+	GetTokenRole(ctx fiber.Ctx, userID int) (role string, sysError syserror.SysError)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v3"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/role/entity"
+)
+
+// IsOrganizationAdmin - User dianggap admin jika punya role admin di organization tersebut
+// atau role superadmin di organization mana pun
+func (u *RoleUsecase) IsOrganizationAdmin(ctx fiber.Ctx, userID int, organizationID int) (isAdmin bool, sysError syserror.SysError) {
+	isAdmin, sysError = u.roleRepo.HasRole(ctx, userID, organizationID, []string{entity.RoleAdmin})
+	if sysError != nil || isAdmin {
+		return
+	}
+
+	isAdmin, sysError = u.roleRepo.HasRole(ctx, userID, 0, []string{entity.RoleSuperadmin})
+	return
+}
+
+// IsElectionObserver - User adalah saksi election jika punya role observer yang terikat ke election tersebut
+func (u *RoleUsecase) IsElectionObserver(ctx fiber.Ctx, userID int, electionID int) (isObserver bool, sysError syserror.SysError) {
+	isObserver, sysError = u.roleRepo.HasElectionRole(ctx, userID, electionID, []string{entity.RoleObserver})
+	return
+}
+
+// IsPollWorker - User adalah petugas TPS election jika punya role poll_worker yang terikat ke election tersebut
+func (u *RoleUsecase) IsPollWorker(ctx fiber.Ctx, userID int, electionID int) (isPollWorker bool, sysError syserror.SysError) {
+	isPollWorker, sysError = u.roleRepo.HasElectionRole(ctx, userID, electionID, []string{entity.RolePollWorker})
+	return
+}
+
+// tokenRolePriority - urutan role yang dipakai sebagai claim token, role pertama yang dimiliki user dipilih
+var tokenRolePriority = []string{entity.RoleSuperadmin, entity.RoleAdmin, entity.RoleObserver, entity.RolePollWorker}
+
+// GetTokenRole - Role tertinggi user dari tabel users_has_roles untuk claim "role" di token.
+// Claim hanya informasi untuk klien, hak akses tetap dicek ke database pada setiap request
+func (u *RoleUsecase) GetTokenRole(ctx fiber.Ctx, userID int) (role string, sysError syserror.SysError) {
+	names, sysError := u.roleRepo.GetRoleNames(ctx, userID)
+	if sysError != nil {
+		return
+	}
+
+	for _, candidate := range tokenRolePriority {
+		if slices.Contains(names, candidate) {
+			role = candidate
+			return
+		}
+	}
+	role = entity.RoleUser
+	return
+}
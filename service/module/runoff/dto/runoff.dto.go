@@ -0,0 +1,8 @@
+package dto
+
+// CreateRunoffRequest - DTO pembuatan election runoff untuk contest yang seri, title kosong berarti
+// judul election asal ditambah "Putaran Kedua"
+type CreateRunoffRequest struct {
+	ContestID int    `json:"contest_id" validate:"required,gt=0"`
+	Title     string `json:"title" validate:"omitempty,max=255"`
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"github.com/lib/pq"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+// Runoff - Election putaran berikutnya untuk contest yang berakhir seri dengan tie_break runoff.
+// Election runoff dibuat draft berisi candidate yang seri dan salinan DPT election asal
+type Runoff struct {
+	ID               int               `db:"id" json:"id"`
+	ElectionID       int               `db:"election_id" json:"election_id"`
+	ContestID        int               `db:"contest_id" json:"contest_id"`
+	RunoffElectionID int               `db:"runoff_election_id" json:"runoff_election_id"`
+	Seats            int               `db:"seats" json:"seats"`                 // kursi yang belum terisi karena seri
+	CandidateIDs     pq.Int64Array     `db:"candidate_ids" json:"candidate_ids"` // id candidate yang seri pada election asal
+	CreatedBy        *int              `db:"created_by" json:"created_by"`
+	CreatedAt        helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (Runoff) TableName() string {
+	return "election_runoffs"
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/runoff/usecase"
+
+type RunoffHandler struct {
+	RunoffUsecase usecase.IRunoffUsecase
+}
+
+func InitRunoffHandler(runoffUsecase usecase.IRunoffUsecase) *RunoffHandler {
+	return &RunoffHandler{
+		RunoffUsecase: runoffUsecase,
+	}
+}
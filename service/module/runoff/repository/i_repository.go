@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/entity"
+)
+
+type RunoffRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitRunoffRepository(mainDB *database.MainDB) IRunoffRepository {
+	return &RunoffRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *RunoffRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IRunoffRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+	GetRunoffsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Runoff, sysError syserror.SysError)
+	CreateRunoff(ctx fiber.Ctx, runoff entity.Runoff, title string) (res entity.Runoff, sysError syserror.SysError)
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/entity"
+)
+
+const runoffColumns = `id, election_id, contest_id, runoff_election_id, seats, candidate_ids, created_by, created_at`
+
+// GetRunoffsByElectionID - Runoff yang dibuat dari contest election
+func (r *RunoffRepository) GetRunoffsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.Runoff, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + runoffColumns + ` FROM public.election_runoffs WHERE election_id = $1 ORDER BY id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil runoff")
+		return
+	}
+	return
+}
+
+// CreateRunoff - Buat election draft berisi satu contest dengan candidate yang seri (nomor urut mengikuti
+// nomor urut asal, waktu pendaftaran dipertahankan) dan salinan seluruh DPT election asal dalam satu statement
+func (r *RunoffRepository) CreateRunoff(ctx fiber.Ctx, runoff entity.Runoff, title string) (res entity.Runoff, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `WITH election AS (
+	              INSERT INTO public.elections (organization_id, title, description, status, type, voting_method, proxy_limit, allow_revote,
+	                  candidate_order, dispute_window_days, eligibility_rules, created_by, created_at, updated_at)
+	              SELECT s.organization_id, $3, s.description, 'draft', 'election', c.voting_method, s.proxy_limit, s.allow_revote,
+	                  s.candidate_order, s.dispute_window_days, s.eligibility_rules, $6, $7, $7
+	              FROM public.elections s
+	              JOIN public.contests c ON c.election_id = s.id AND c.id = $2
+	              WHERE s.id = $1
+	              RETURNING id, created_at
+	          ), contest AS (
+	              INSERT INTO public.contests (election_id, name, seats, voting_method, position, kind, description, tie_break, created_at, updated_at)
+	              SELECT e.id, c.name, $4, c.voting_method, 1, 'candidate', c.description, c.tie_break, e.created_at, e.created_at
+	              FROM election e, public.contests c
+	              WHERE c.id = $2
+	              RETURNING id, election_id, created_at
+	          ), candidates AS (
+	              INSERT INTO public.candidates (election_id, contest_id, ballot_number, name, photo_url, vision, mission,
+	                  running_mate_name, running_mate_photo_url, created_at, updated_at)
+	              SELECT ct.election_id, ct.id, ROW_NUMBER() OVER (ORDER BY o.ballot_number, o.id), o.name, o.photo_url, o.vision, o.mission,
+	                  o.running_mate_name, o.running_mate_photo_url, o.created_at, ct.created_at
+	              FROM contest ct, public.candidates o
+	              WHERE o.contest_id = $2 AND o.id = ANY($5)
+	          ), voters AS (
+	              INSERT INTO public.voter_rolls (election_id, user_id, name, email, member_number, source, weight, created_at)
+	              SELECT e.id, v.user_id, v.name, v.email, v.member_number, v.source, v.weight, e.created_at
+	              FROM election e, public.voter_rolls v
+	              WHERE v.election_id = $1
+	          )
+	          INSERT INTO public.election_runoffs (election_id, contest_id, runoff_election_id, seats, candidate_ids, created_by, created_at)
+	          SELECT $1, $2, e.id, $4, $5, $6, $7 FROM election e
+	          RETURNING ` + runoffColumns
+
+	model := db.Get(&res, query, runoff.ElectionID, runoff.ContestID, title, runoff.Seats, runoff.CandidateIDs, runoff.CreatedBy, helper.Now())
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Contest tidak ditemukan")
+		return
+	} else if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Runoff untuk contest ini sudah dibuat")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat election runoff")
+	}
+	return
+}
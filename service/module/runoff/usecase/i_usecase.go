@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	ballotUsecase "github.com/madmuzz05/be-enyoblos/service/module/ballot/usecase"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/repository"
+)
+
+type RunoffUsecase struct {
+	runoffRepo  repository.IRunoffRepository
+	electionUse electionUsecase.IElectionUsecase
+	ballotUse   ballotUsecase.IBallotUsecase
+	mainDB      *dbpostgres.MainDB
+}
+
+func InitRunoffUsecase(runoffRepo repository.IRunoffRepository, electionUse electionUsecase.IElectionUsecase, ballotUse ballotUsecase.IBallotUsecase, mainDB *dbpostgres.MainDB) IRunoffUsecase {
+	return &RunoffUsecase{
+		runoffRepo:  runoffRepo,
+		electionUse: electionUse,
+		ballotUse:   ballotUse,
+		mainDB:      mainDB,
+	}
+}
+
+type IRunoffUsecase interface {
+	GetRunoffs(ctx fiber.Ctx, electionID int) (res []entity.Runoff, sysError syserror.SysError)
+	CreateRunoff(ctx fiber.Ctx, electionID int, req dto.CreateRunoffRequest) (res entity.Runoff, sysError syserror.SysError)
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	ballotDto "github.com/madmuzz05/be-enyoblos/service/module/ballot/dto"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/entity"
+)
+
+// maxTitleLength - panjang maksimal judul election
+const maxTitleLength = 255
+
+// GetRunoffs - Daftar runoff yang dibuat dari contest election
+func (u *RunoffUsecase) GetRunoffs(ctx fiber.Ctx, electionID int) (res []entity.Runoff, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.runoffRepo.GetRunoffsByElectionID(ctx, electionID)
+	return
+}
+
+// CreateRunoff - Buat election runoff (draft) untuk contest yang seri dengan tie_break runoff (admin organization).
+// Candidate yang seri dan jumlah kursi yang kosong diambil dari hasil penghitungan, bukan dari request,
+// DPT disalin dari election asal
+func (u *RunoffUsecase) CreateRunoff(ctx fiber.Ctx, electionID int, req dto.CreateRunoffRequest) (res entity.Runoff, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusClosed && election.Status != electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Runoff hanya dapat dibuat setelah election ditutup")
+		return
+	}
+
+	results, sysError := u.ballotUse.GetResults(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	var contest *ballotDto.ContestResult
+	for i := range results.Contests {
+		if results.Contests[i].Contest.ID == req.ContestID {
+			contest = &results.Contests[i]
+			break
+		}
+	}
+	if contest == nil {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Contest tidak ditemukan")
+		return
+	}
+	if contest.TieBreak == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Contest "+contest.Contest.Name+" tidak berakhir seri")
+		return
+	}
+	if !contest.TieBreak.Pending {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Seri contest "+contest.Contest.Name+" diselesaikan dengan "+contest.TieBreak.Policy+", bukan runoff")
+		return
+	}
+
+	title := req.Title
+	if title == "" {
+		title = truncate(election.Title+" - Putaran Kedua", maxTitleLength)
+	}
+
+	candidateIDs := make([]int64, 0, len(contest.TieBreak.Tied))
+	for _, candidateID := range contest.TieBreak.Tied {
+		candidateIDs = append(candidateIDs, int64(candidateID))
+	}
+
+	res, sysError = u.runoffRepo.CreateRunoff(ctx, entity.Runoff{
+		ElectionID:   electionID,
+		ContestID:    req.ContestID,
+		Seats:        contest.TieBreak.Seats,
+		CandidateIDs: candidateIDs,
+		CreatedBy:    &userID,
+	}, title)
+	return
+}
+
+// truncate - potong teks menjadi maksimal max karakter (bukan byte)
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
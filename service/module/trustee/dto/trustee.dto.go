@@ -0,0 +1,75 @@
+package dto
+
+// AddTrusteeRequest - DTO untuk menunjuk anggota organization sebagai trustee
+type AddTrusteeRequest struct {
+	UserID int `json:"user_id" validate:"required,gt=0"`
+}
+
+// RegisterPublicKeyRequest - Public key X25519 (base64) milik trustee untuk menerima share
+type RegisterPublicKeyRequest struct {
+	PublicKey string `json:"public_key" validate:"required,base64"`
+}
+
+// KeyCeremonyRequest - Jumlah minimal trustee yang dibutuhkan untuk mendekripsi ballot
+type KeyCeremonyRequest struct {
+	Threshold int `json:"threshold" validate:"required,gte=1"`
+}
+
+// ContributionRecipient - Trustee penerima share kontribusi beserta context enkripsinya
+type ContributionRecipient struct {
+	TrusteeID int    `json:"trustee_id"`
+	Position  int    `json:"position"`
+	PublicKey string `json:"public_key"`
+	Context   string `json:"context"`
+}
+
+// ContributionTargetsResponse - Bahan yang dibutuhkan trustee untuk membuat kontribusi kunci di perangkatnya
+type ContributionTargetsResponse struct {
+	ElectionID   int                     `json:"election_id"`
+	TrusteeID    int                     `json:"trustee_id"`
+	Threshold    int                     `json:"threshold"`
+	TrusteeCount int                     `json:"trustee_count"`
+	Recipients   []ContributionRecipient `json:"recipients"`
+}
+
+// ContributionShareRequest - Share kontribusi (terenkripsi) untuk satu trustee beserta sha256 (hex) share aslinya
+type ContributionShareRequest struct {
+	TrusteeID      int    `json:"trustee_id" validate:"required,gt=0"`
+	EncryptedShare string `json:"encrypted_share" validate:"required,base64"`
+	ShareHash      string `json:"share_hash" validate:"required,len=64,hexadecimal"`
+}
+
+// SubmitContributionRequest - Public key kontribusi X25519 (base64) dan share private key-nya untuk seluruh trustee.
+// Private key kontribusi tidak pernah dikirim ke server
+type SubmitContributionRequest struct {
+	PublicKey string                     `json:"public_key" validate:"required,base64"`
+	Shares    []ContributionShareRequest `json:"shares" validate:"required,min=1,dive"`
+}
+
+// TrusteeShare - Share satu kontribusi yang terenkripsi ke public key trustee
+type TrusteeShare struct {
+	ContributionID int    `json:"contribution_id"`
+	EncryptedShare string `json:"encrypted_share"`
+	Context        string `json:"context"`
+}
+
+// TrusteeShareResponse - Share milik trustee yang terenkripsi ke public key-nya, satu per kontribusi
+type TrusteeShareResponse struct {
+	ElectionID   int            `json:"election_id"`
+	TrusteeID    int            `json:"trustee_id"`
+	Position     int            `json:"position"`
+	Threshold    int            `json:"threshold"`
+	TrusteeCount int            `json:"trustee_count"`
+	Shares       []TrusteeShare `json:"shares"`
+}
+
+// SubmittedShare - Share (base64) satu kontribusi yang sudah didekripsi trustee
+type SubmittedShare struct {
+	ContributionID int    `json:"contribution_id" validate:"required,gt=0"`
+	Share          string `json:"share" validate:"required,base64"`
+}
+
+// SubmitShareRequest - Seluruh share milik trustee, dikirim setelah election ditutup
+type SubmitShareRequest struct {
+	Shares []SubmittedShare `json:"shares" validate:"required,min=1,dive"`
+}
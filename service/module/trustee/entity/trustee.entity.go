@@ -0,0 +1,65 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+// Trustee - Pemegang kontribusi kunci election dan share kontribusi trustee lain. Share hanya disimpan
+// terenkripsi ke public key trustee, share asli baru dikirim trustee setelah election ditutup
+type Trustee struct {
+	ID            int                `db:"id" json:"id"`
+	ElectionID    int                `db:"election_id" json:"election_id"`
+	UserID        int                `db:"user_id" json:"user_id"`
+	Position      int                `db:"position" json:"position"`
+	PublicKey     *string            `db:"public_key" json:"public_key"`
+	ContributedAt *helper.CustomTime `db:"contributed_at" json:"contributed_at"`
+	SubmittedAt   *helper.CustomTime `db:"submitted_at" json:"submitted_at"`
+	CreatedAt     helper.CustomTime  `db:"created_at" json:"created_at"`
+}
+
+func (Trustee) TableName() string {
+	return "election_trustees"
+}
+
+// ElectionKey - Key ceremony election. Ballot baru bisa dienkripsi setelah CompletedAt terisi, yaitu saat
+// seluruh trustee mengirim kontribusi, dan baru bisa dibuka setelah minimal Threshold trustee mengirim share-nya
+type ElectionKey struct {
+	ElectionID    int                `db:"election_id" json:"election_id"`
+	Threshold     int                `db:"threshold" json:"threshold"`
+	TrusteeCount  int                `db:"trustee_count" json:"trustee_count"`
+	CreatedAt     helper.CustomTime  `db:"created_at" json:"created_at"`
+	CompletedAt   *helper.CustomTime `db:"completed_at" json:"completed_at"`
+	DecryptedAt   *helper.CustomTime `db:"decrypted_at" json:"decrypted_at"`
+	Contributions []KeyContribution  `db:"-" json:"contributions"`
+}
+
+func (ElectionKey) TableName() string {
+	return "election_keys"
+}
+
+// KeyContribution - Public key kontribusi satu trustee. PrivateKey kosong sampai direkonstruksi dari share
+// setelah election ditutup. TrusteeID kosong berarti kunci lama yang dibuat server saat key ceremony
+type KeyContribution struct {
+	ID         int               `db:"id" json:"id"`
+	ElectionID int               `db:"election_id" json:"election_id"`
+	TrusteeID  *int              `db:"trustee_id" json:"trustee_id"`
+	PublicKey  string            `db:"public_key" json:"public_key"`
+	PrivateKey *string           `db:"private_key" json:"private_key"`
+	CreatedAt  helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (KeyContribution) TableName() string {
+	return "election_key_contributions"
+}
+
+// KeyShare - Share private key satu kontribusi untuk satu trustee
+type KeyShare struct {
+	ContributionID int     `db:"contribution_id" json:"contribution_id"`
+	TrusteeID      int     `db:"trustee_id" json:"trustee_id"`
+	EncryptedShare string  `db:"encrypted_share" json:"-"`
+	ShareHash      string  `db:"share_hash" json:"-"`
+	Context        string  `db:"context" json:"context"`
+	Share          *string `db:"share" json:"-"`
+}
+
+func (KeyShare) TableName() string {
+	return "election_key_shares"
+}
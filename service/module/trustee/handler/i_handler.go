@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/trustee/usecase"
+
+type TrusteeHandler struct {
+	TrusteeUsecase usecase.ITrusteeUsecase
+}
+
+func InitTrusteeHandler(trusteeUsecase usecase.ITrusteeUsecase) *TrusteeHandler {
+	return &TrusteeHandler{
+		TrusteeUsecase: trusteeUsecase,
+	}
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/dto"
+)
+
+// GetElectionKey - Public key kontribusi election, status key ceremony dan dekripsi
+// @GET /elections/:id/key
+func (h *TrusteeHandler) GetElectionKey(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.TrusteeUsecase.GetElectionKey(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election key retrieved successfully", res)
+}
+
+// GetTrustees - Daftar trustee election
+// @GET /elections/:id/trustees
+func (h *TrusteeHandler) GetTrustees(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.TrusteeUsecase.GetTrustees(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Trustees retrieved successfully", res)
+}
+
+// AddTrustee - Tunjuk trustee election
+// @POST /elections/:id/trustees
+// Body: {user_id: int}
+func (h *TrusteeHandler) AddTrustee(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.AddTrusteeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.TrusteeUsecase.AddTrustee(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Trustee added successfully", res)
+}
+
+// DeleteTrustee - Hapus trustee election
+// @DELETE /elections/:id/trustees/:trustee_id
+func (h *TrusteeHandler) DeleteTrustee(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	trusteeID, err := strconv.Atoi(ctx.Params("trustee_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid trustee ID", err)
+	}
+
+	if sysErr := h.TrusteeUsecase.DeleteTrustee(ctx, electionID, trusteeID); sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Trustee deleted successfully", nil)
+}
+
+// RegisterPublicKey - Trustee mendaftarkan public key X25519 miliknya
+// @PUT /elections/:id/trustees/me/public-key
+// Body: {public_key: string(base64)}
+func (h *TrusteeHandler) RegisterPublicKey(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.RegisterPublicKeyRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.TrusteeUsecase.RegisterPublicKey(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Public key registered successfully", res)
+}
+
+// GetContributionTargets - Public key trustee penerima share kontribusi kunci milik trustee yang login
+// @GET /elections/:id/trustees/me/contribution
+func (h *TrusteeHandler) GetContributionTargets(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.TrusteeUsecase.GetContributionTargets(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Contribution targets retrieved successfully", res)
+}
+
+// SubmitContribution - Trustee mengirim public key kontribusi kunci dan share terenkripsinya
+// @POST /elections/:id/trustees/me/contribution
+// Body: {public_key: string(base64), shares: [{trustee_id: int, encrypted_share: string(base64), share_hash: string(hex sha256)}]}
+func (h *TrusteeHandler) SubmitContribution(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.SubmitContributionRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.TrusteeUsecase.SubmitContribution(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Key contribution submitted successfully", res)
+}
+
+// GetMyShare - Share terenkripsi milik trustee yang login
+// @GET /elections/:id/trustees/me/share
+func (h *TrusteeHandler) GetMyShare(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.TrusteeUsecase.GetMyShare(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Trustee share retrieved successfully", res)
+}
+
+// SubmitShare - Trustee mengirim share yang sudah dibuka setelah election ditutup
+// @POST /elections/:id/trustees/me/share
+// Body: {shares: [{contribution_id: int, share: string(base64)}]}
+func (h *TrusteeHandler) SubmitShare(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.SubmitShareRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.TrusteeUsecase.SubmitShare(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Share submitted successfully", res)
+}
+
+// RunKeyCeremony - Mulai key ceremony, kunci election dibentuk dari kontribusi trustee
+// @POST /elections/:id/key-ceremony
+// Body: {threshold: int}
+func (h *TrusteeHandler) RunKeyCeremony(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.KeyCeremonyRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.TrusteeUsecase.RunKeyCeremony(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusCreated, "Key ceremony started successfully", res)
+}
+
+// DecryptElection - Rekonstruksi kunci kontribusi election dari share trustee
+// @POST /elections/:id/decrypt
+func (h *TrusteeHandler) DecryptElection(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.TrusteeUsecase.DecryptElection(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Election decrypted successfully", res)
+}
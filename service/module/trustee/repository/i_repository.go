@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/entity"
+)
+
+type TrusteeRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitTrusteeRepository(mainDB *database.MainDB) ITrusteeRepository {
+	return &TrusteeRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *TrusteeRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type ITrusteeRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetTrustees(ctx fiber.Ctx, electionID int) (res []entity.Trustee, sysError syserror.SysError)
+	GetTrusteeByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.Trustee, sysError syserror.SysError)
+	CreateTrustee(ctx fiber.Ctx, trustee entity.Trustee) (res entity.Trustee, sysError syserror.SysError)
+	DeleteTrustee(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	UpdateTrusteePublicKey(ctx fiber.Ctx, id int, publicKey string) (res entity.Trustee, sysError syserror.SysError)
+	MarkTrusteeContributed(ctx fiber.Ctx, id int, contributedAt helper.CustomTime) (sysError syserror.SysError)
+	MarkTrusteeSubmitted(ctx fiber.Ctx, id int, submittedAt helper.CustomTime) (res entity.Trustee, sysError syserror.SysError)
+
+	GetElectionKey(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError)
+	GetElectionKeyForUpdate(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError)
+	CreateElectionKey(ctx fiber.Ctx, key entity.ElectionKey) (res entity.ElectionKey, sysError syserror.SysError)
+	CompleteElectionKey(ctx fiber.Ctx, electionID int, completedAt helper.CustomTime) (sysError syserror.SysError)
+	MarkElectionDecrypted(ctx fiber.Ctx, electionID int, decryptedAt helper.CustomTime) (res entity.ElectionKey, sysError syserror.SysError)
+
+	GetKeyContributions(ctx fiber.Ctx, electionID int) (res []entity.KeyContribution, sysError syserror.SysError)
+	CreateKeyContribution(ctx fiber.Ctx, contribution entity.KeyContribution) (res entity.KeyContribution, sysError syserror.SysError)
+	UpdateContributionPrivateKey(ctx fiber.Ctx, id int, privateKey string) (sysError syserror.SysError)
+	CreateKeyShares(ctx fiber.Ctx, shares []entity.KeyShare) (sysError syserror.SysError)
+	GetTrusteeKeyShares(ctx fiber.Ctx, trusteeID int) (res []entity.KeyShare, sysError syserror.SysError)
+	GetSubmittedKeyShares(ctx fiber.Ctx, electionID int) (res []entity.KeyShare, sysError syserror.SysError)
+	SubmitKeyShare(ctx fiber.Ctx, contributionID int, trusteeID int, share string) (sysError syserror.SysError)
+}
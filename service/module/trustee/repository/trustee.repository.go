@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/entity"
+)
+
+const trusteeColumns = `id, election_id, user_id, position, public_key, contributed_at, submitted_at, created_at`
+
+const electionKeyColumns = `election_id, threshold, trustee_count, created_at, completed_at, decrypted_at`
+
+const contributionColumns = `id, election_id, trustee_id, public_key, private_key, created_at`
+
+const keyShareColumns = `contribution_id, trustee_id, encrypted_share, share_hash, context, share`
+
+func (r *TrusteeRepository) GetTrustees(ctx fiber.Ctx, electionID int) (res []entity.Trustee, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + trusteeColumns + ` FROM public.election_trustees WHERE election_id = $1 ORDER BY position`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil trustee")
+		return
+	}
+	return
+}
+
+func (r *TrusteeRepository) GetTrusteeByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.Trustee, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + trusteeColumns + ` FROM public.election_trustees WHERE election_id = $1 AND user_id = $2`
+
+	model := db.Get(&res, query, electionID, userID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrForbidden, fiber.StatusForbidden, "Anda bukan trustee election ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil trustee")
+	}
+	return
+}
+
+// CreateTrustee - Tambah trustee dengan posisi berikutnya pada election
+func (r *TrusteeRepository) CreateTrustee(ctx fiber.Ctx, trustee entity.Trustee) (res entity.Trustee, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_trustees (election_id, user_id, position, created_at)
+	          SELECT $1, $2, COALESCE(MAX(position), 0) + 1, $3 FROM public.election_trustees WHERE election_id = $1
+	          RETURNING ` + trusteeColumns
+
+	model := db.Get(&res, query, trustee.ElectionID, trustee.UserID, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "User sudah menjadi trustee election ini")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menambahkan trustee")
+	}
+	return
+}
+
+func (r *TrusteeRepository) DeleteTrustee(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.election_trustees WHERE id = $1 AND election_id = $2`
+
+	result, err := db.Exec(query, id, electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus trustee")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Trustee tidak ditemukan")
+		return
+	}
+	return
+}
+
+func (r *TrusteeRepository) UpdateTrusteePublicKey(ctx fiber.Ctx, id int, publicKey string) (res entity.Trustee, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_trustees SET public_key = $1 WHERE id = $2 RETURNING ` + trusteeColumns
+
+	model := db.Get(&res, query, publicKey, id)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan public key trustee")
+	}
+	return
+}
+
+func (r *TrusteeRepository) MarkTrusteeContributed(ctx fiber.Ctx, id int, contributedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_trustees SET contributed_at = $1 WHERE id = $2`
+
+	if _, err := db.Exec(query, contributedAt, id); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan kontribusi trustee")
+	}
+	return
+}
+
+func (r *TrusteeRepository) MarkTrusteeSubmitted(ctx fiber.Ctx, id int, submittedAt helper.CustomTime) (res entity.Trustee, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_trustees SET submitted_at = $1 WHERE id = $2 RETURNING ` + trusteeColumns
+
+	model := db.Get(&res, query, submittedAt, id)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan share trustee")
+	}
+	return
+}
+
+func (r *TrusteeRepository) GetElectionKey(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError) {
+	return r.getElectionKey(ctx, electionID, "")
+}
+
+// GetElectionKeyForUpdate - Kunci row key ceremony supaya kontribusi trustee yang masuk bersamaan
+// tidak sama-sama mengira kontribusinya bukan yang terakhir
+func (r *TrusteeRepository) GetElectionKeyForUpdate(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError) {
+	return r.getElectionKey(ctx, electionID, " FOR UPDATE")
+}
+
+func (r *TrusteeRepository) getElectionKey(ctx fiber.Ctx, electionID int, lock string) (res entity.ElectionKey, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + electionKeyColumns + ` FROM public.election_keys WHERE election_id = $1` + lock
+
+	model := db.Get(&res, query, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak memakai ballot terenkripsi")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kunci election")
+	}
+	return
+}
+
+func (r *TrusteeRepository) CreateElectionKey(ctx fiber.Ctx, key entity.ElectionKey) (res entity.ElectionKey, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_keys (election_id, threshold, trustee_count, created_at)
+	          VALUES ($1, $2, $3, $4)
+	          RETURNING ` + electionKeyColumns
+
+	model := db.Get(&res, query, key.ElectionID, key.Threshold, key.TrusteeCount, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Key ceremony election ini sudah dilakukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan kunci election")
+	}
+	return
+}
+
+func (r *TrusteeRepository) CompleteElectionKey(ctx fiber.Ctx, electionID int, completedAt helper.CustomTime) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_keys SET completed_at = $1 WHERE election_id = $2`
+
+	if _, err := db.Exec(query, completedAt, electionID); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan kunci election")
+	}
+	return
+}
+
+func (r *TrusteeRepository) MarkElectionDecrypted(ctx fiber.Ctx, electionID int, decryptedAt helper.CustomTime) (res entity.ElectionKey, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_keys SET decrypted_at = $1 WHERE election_id = $2 RETURNING ` + electionKeyColumns
+
+	model := db.Get(&res, query, decryptedAt, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan kunci election")
+	}
+	return
+}
+
+// GetKeyContributions - Kontribusi kunci election, urutannya adalah urutan lapisan enkripsi ballot
+func (r *TrusteeRepository) GetKeyContributions(ctx fiber.Ctx, electionID int) (res []entity.KeyContribution, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + contributionColumns + ` FROM public.election_key_contributions WHERE election_id = $1 ORDER BY id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kontribusi kunci election")
+	}
+	return
+}
+
+func (r *TrusteeRepository) CreateKeyContribution(ctx fiber.Ctx, contribution entity.KeyContribution) (res entity.KeyContribution, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.election_key_contributions (election_id, trustee_id, public_key, created_at)
+	          VALUES ($1, $2, $3, $4)
+	          RETURNING ` + contributionColumns
+
+	model := db.Get(&res, query, contribution.ElectionID, contribution.TrusteeID, contribution.PublicKey, helper.Now())
+	if database.IsUniqueViolation(model) {
+		sysError = syserror.CreateError(model, fiber.StatusConflict, "Kontribusi kunci sudah dikirim")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal menyimpan kontribusi kunci")
+	}
+	return
+}
+
+func (r *TrusteeRepository) UpdateContributionPrivateKey(ctx fiber.Ctx, id int, privateKey string) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_key_contributions SET private_key = $1 WHERE id = $2`
+
+	if _, err := db.Exec(query, privateKey, id); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan kunci election")
+	}
+	return
+}
+
+// CreateKeyShares - Simpan share terenkripsi satu kontribusi untuk seluruh trustee
+func (r *TrusteeRepository) CreateKeyShares(ctx fiber.Ctx, shares []entity.KeyShare) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	contributionIDs := make([]int, len(shares))
+	trusteeIDs := make([]int, len(shares))
+	encryptedShares := make([]string, len(shares))
+	shareHashes := make([]string, len(shares))
+	contexts := make([]string, len(shares))
+	for i, share := range shares {
+		contributionIDs[i] = share.ContributionID
+		trusteeIDs[i] = share.TrusteeID
+		encryptedShares[i] = share.EncryptedShare
+		shareHashes[i] = share.ShareHash
+		contexts[i] = share.Context
+	}
+
+	query := `INSERT INTO public.election_key_shares (contribution_id, trustee_id, encrypted_share, share_hash, context)
+	          SELECT * FROM UNNEST($1::int[], $2::int[], $3::text[], $4::text[], $5::text[])`
+
+	_, err := db.Exec(query, pq.Array(contributionIDs), pq.Array(trusteeIDs), pq.Array(encryptedShares), pq.Array(shareHashes), pq.Array(contexts))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan share trustee")
+	}
+	return
+}
+
+// GetTrusteeKeyShares - Share seluruh kontribusi milik satu trustee
+func (r *TrusteeRepository) GetTrusteeKeyShares(ctx fiber.Ctx, trusteeID int) (res []entity.KeyShare, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + keyShareColumns + ` FROM public.election_key_shares WHERE trustee_id = $1 ORDER BY contribution_id`
+
+	model := db.Select(&res, query, trusteeID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil share trustee")
+	}
+	return
+}
+
+// GetSubmittedKeyShares - Share yang sudah dikirim trustee untuk seluruh kontribusi election
+func (r *TrusteeRepository) GetSubmittedKeyShares(ctx fiber.Ctx, electionID int) (res []entity.KeyShare, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT s.contribution_id, s.trustee_id, s.encrypted_share, s.share_hash, s.context, s.share
+	          FROM public.election_key_shares s
+	          JOIN public.election_key_contributions c ON c.id = s.contribution_id
+	          WHERE c.election_id = $1 AND s.share IS NOT NULL
+	          ORDER BY s.contribution_id, s.trustee_id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil share trustee")
+	}
+	return
+}
+
+func (r *TrusteeRepository) SubmitKeyShare(ctx fiber.Ctx, contributionID int, trusteeID int, share string) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.election_key_shares SET share = $1 WHERE contribution_id = $2 AND trustee_id = $3`
+
+	if _, err := db.Exec(query, share, contributionID, trusteeID); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan share trustee")
+	}
+	return
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/repository"
+	userUsecase "github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
+)
+
+type TrusteeUsecase struct {
+	trusteeRepo repository.ITrusteeRepository
+	electionUse electionUsecase.IElectionUsecase
+	userUse     userUsecase.IUserUsecase
+	mainDB      *dbpostgres.MainDB
+}
+
+func InitTrusteeUsecase(trusteeRepo repository.ITrusteeRepository, electionUse electionUsecase.IElectionUsecase, userUse userUsecase.IUserUsecase, mainDB *dbpostgres.MainDB) ITrusteeUsecase {
+	return &TrusteeUsecase{
+		trusteeRepo: trusteeRepo,
+		electionUse: electionUse,
+		userUse:     userUse,
+		mainDB:      mainDB,
+	}
+}
+
+type ITrusteeUsecase interface {
+	GetTrustees(ctx fiber.Ctx, electionID int) (res []entity.Trustee, sysError syserror.SysError)
+	AddTrustee(ctx fiber.Ctx, electionID int, req dto.AddTrusteeRequest) (res entity.Trustee, sysError syserror.SysError)
+	DeleteTrustee(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	RegisterPublicKey(ctx fiber.Ctx, electionID int, req dto.RegisterPublicKeyRequest) (res entity.Trustee, sysError syserror.SysError)
+	RunKeyCeremony(ctx fiber.Ctx, electionID int, req dto.KeyCeremonyRequest) (res entity.ElectionKey, sysError syserror.SysError)
+	GetContributionTargets(ctx fiber.Ctx, electionID int) (res dto.ContributionTargetsResponse, sysError syserror.SysError)
+	SubmitContribution(ctx fiber.Ctx, electionID int, req dto.SubmitContributionRequest) (res entity.ElectionKey, sysError syserror.SysError)
+	GetMyShare(ctx fiber.Ctx, electionID int) (res dto.TrusteeShareResponse, sysError syserror.SysError)
+	SubmitShare(ctx fiber.Ctx, electionID int, req dto.SubmitShareRequest) (res entity.Trustee, sysError syserror.SysError)
+	DecryptElection(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError)
+	GetElectionKey(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError)
+	EncryptBallot(ctx fiber.Ctx, electionID int, content []byte) (ciphertext string, encrypted bool, sysError syserror.SysError)
+	DecryptBallots(ctx fiber.Ctx, electionID int, ciphertexts []string) (res [][]byte, sysError syserror.SysError)
+}
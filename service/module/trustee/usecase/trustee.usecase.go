@@ -0,0 +1,727 @@
+package usecase
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	"github.com/madmuzz05/be-enyoblos/package/electioncrypto"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/shamir"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/entity"
+)
+
+// ballotPadding - isi ballot dipadding ke kelipatan ini supaya panjang ciphertext tidak membocorkan jumlah pilihan
+const ballotPadding = 256
+
+// GetTrustees - Daftar trustee election beserta status public key dan share (admin organization)
+func (u *TrusteeUsecase) GetTrustees(ctx fiber.Ctx, electionID int) (res []entity.Trustee, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.trusteeRepo.GetTrustees(ctx, electionID)
+	return
+}
+
+// AddTrustee - Tunjuk anggota organization sebagai trustee, hanya sebelum key ceremony
+func (u *TrusteeUsecase) AddTrustee(ctx fiber.Ctx, electionID int, req dto.AddTrusteeRequest) (res entity.Trustee, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureBeforeCeremony(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	user, sysError := u.userUse.GetUserByID(ctx, strconv.Itoa(req.UserID))
+	if sysError != nil {
+		return
+	}
+	if user.OrganizationID != election.OrganizationID {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Trustee harus anggota organization penyelenggara")
+		return
+	}
+
+	res, sysError = u.trusteeRepo.CreateTrustee(ctx, entity.Trustee{
+		ElectionID: electionID,
+		UserID:     req.UserID,
+	})
+	return
+}
+
+// DeleteTrustee - Hapus trustee, hanya sebelum key ceremony
+func (u *TrusteeUsecase) DeleteTrustee(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureBeforeCeremony(ctx, electionID); sysError != nil {
+		return
+	}
+
+	sysError = u.trusteeRepo.DeleteTrustee(ctx, electionID, id)
+	return
+}
+
+// RegisterPublicKey - Trustee mendaftarkan public key X25519 miliknya untuk menerima share.
+// Private key-nya tidak pernah dikirim ke server
+func (u *TrusteeUsecase) RegisterPublicKey(ctx fiber.Ctx, electionID int, req dto.RegisterPublicKeyRequest) (res entity.Trustee, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	if _, err := electioncrypto.ParsePublicKey(req.PublicKey); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Public key harus X25519 32 byte (base64)")
+		return
+	}
+
+	if _, sysError = u.getCeremonyKey(ctx, electionID, false); sysError != nil {
+		return
+	}
+
+	trustee, sysError := u.trusteeRepo.GetTrusteeByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+
+	res, sysError = u.trusteeRepo.UpdateTrusteePublicKey(ctx, trustee.ID, req.PublicKey)
+	return
+}
+
+// RunKeyCeremony - Mulai key ceremony: tetapkan threshold dari seluruh trustee yang sudah mendaftarkan public key.
+// Kunci election tidak dibuat server, tiap trustee membuat kontribusi kunci di perangkatnya sendiri lalu mengirim
+// public key-nya beserta share private key yang terenkripsi ke trustee lain (SubmitContribution). Ballot baru bisa
+// dienkripsi setelah seluruh kontribusi masuk, sehingga tidak ada pihak, termasuk server, yang memegang kunci
+// untuk membuka ballot sebelum minimal threshold trustee mengirim share-nya setelah election ditutup
+func (u *TrusteeUsecase) RunKeyCeremony(ctx fiber.Ctx, electionID int, req dto.KeyCeremonyRequest) (res entity.ElectionKey, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureBeforeCeremony(ctx, electionID); sysError != nil {
+		return
+	}
+
+	trustees, sysError := u.trusteeRepo.GetTrustees(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if len(trustees) == 0 {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Tunjuk trustee terlebih dahulu")
+		return
+	}
+	if req.Threshold > len(trustees) {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, fmt.Sprintf("threshold maksimal %d (jumlah trustee)", len(trustees)))
+		return
+	}
+	for _, trustee := range trustees {
+		if trustee.PublicKey == nil {
+			sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, fmt.Sprintf("Trustee posisi %d belum mendaftarkan public key", trustee.Position))
+			return
+		}
+	}
+
+	res, sysError = u.trusteeRepo.CreateElectionKey(ctx, entity.ElectionKey{
+		ElectionID:   electionID,
+		Threshold:    req.Threshold,
+		TrusteeCount: len(trustees),
+	})
+	res.Contributions = []entity.KeyContribution{}
+	return
+}
+
+// GetContributionTargets - Public key seluruh trustee dan context enkripsi share untuk trustee yang login.
+// Di perangkatnya trustee membuat pasangan kunci X25519, membagi private key dengan Shamir (format package shamir,
+// koordinat x = posisi trustee penerima) sebanyak trustee_count dengan ambang threshold, lalu mengenkripsi
+// setiap share ke public key penerimanya (X25519 + HKDF-SHA256 + AES-256-GCM dengan context yang dikembalikan)
+func (u *TrusteeUsecase) GetContributionTargets(ctx fiber.Ctx, electionID int) (res dto.ContributionTargetsResponse, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	trustee, sysError := u.trusteeRepo.GetTrusteeByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+
+	key, sysError := u.getCeremonyKey(ctx, electionID, true)
+	if sysError != nil {
+		return
+	}
+
+	trustees, sysError := u.trusteeRepo.GetTrustees(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.ContributionTargetsResponse{
+		ElectionID:   electionID,
+		TrusteeID:    trustee.ID,
+		Threshold:    key.Threshold,
+		TrusteeCount: key.TrusteeCount,
+		Recipients:   make([]dto.ContributionRecipient, 0, len(trustees)),
+	}
+	for _, recipient := range trustees {
+		if recipient.PublicKey == nil {
+			continue
+		}
+		res.Recipients = append(res.Recipients, dto.ContributionRecipient{
+			TrusteeID: recipient.ID,
+			Position:  recipient.Position,
+			PublicKey: *recipient.PublicKey,
+			Context:   shareContext(electionID, trustee.ID, recipient.ID),
+		})
+	}
+	return
+}
+
+// SubmitContribution - Trustee mengirim public key kontribusinya dan share private key-nya untuk setiap trustee.
+// Private key kontribusi tidak pernah dikirim ke server. Kontribusi terakhir menyelesaikan key ceremony
+func (u *TrusteeUsecase) SubmitContribution(ctx fiber.Ctx, electionID int, req dto.SubmitContributionRequest) (res entity.ElectionKey, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	if _, err := electioncrypto.ParsePublicKey(req.PublicKey); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Public key harus X25519 32 byte (base64)")
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetElectionByIDForShare(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusDraft && election.Status != electionEntity.StatusScheduled {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kontribusi kunci hanya dapat dikirim sebelum election dibuka")
+		return
+	}
+
+	key, sysError := u.trusteeRepo.GetElectionKeyForUpdate(ctx, electionID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini belum dilakukan")
+		}
+		return
+	}
+	if key.CompletedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini sudah selesai")
+		return
+	}
+
+	trustee, sysError := u.trusteeRepo.GetTrusteeByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+	if trustee.ContributedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kontribusi kunci sudah dikirim")
+		return
+	}
+
+	trustees, sysError := u.trusteeRepo.GetTrustees(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	recipients := make(map[int]bool, len(trustees))
+	for _, recipient := range trustees {
+		recipients[recipient.ID] = true
+	}
+	shares := make([]entity.KeyShare, 0, len(req.Shares))
+	for _, share := range req.Shares {
+		if !recipients[share.TrusteeID] {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Share kontribusi harus tepat satu untuk setiap trustee")
+			return
+		}
+		delete(recipients, share.TrusteeID)
+
+		shares = append(shares, entity.KeyShare{
+			TrusteeID:      share.TrusteeID,
+			EncryptedShare: share.EncryptedShare,
+			ShareHash:      strings.ToLower(share.ShareHash),
+			Context:        shareContext(electionID, trustee.ID, share.TrusteeID),
+		})
+	}
+	if len(recipients) > 0 {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Share kontribusi harus tepat satu untuk setiap trustee")
+		return
+	}
+
+	contribution, sysError := u.trusteeRepo.CreateKeyContribution(ctx, entity.KeyContribution{
+		ElectionID: electionID,
+		TrusteeID:  &trustee.ID,
+		PublicKey:  req.PublicKey,
+	})
+	if sysError != nil {
+		return
+	}
+	for i := range shares {
+		shares[i].ContributionID = contribution.ID
+	}
+	if sysError = u.trusteeRepo.CreateKeyShares(ctx, shares); sysError != nil {
+		return
+	}
+
+	now := helper.Now()
+	if sysError = u.trusteeRepo.MarkTrusteeContributed(ctx, trustee.ID, now); sysError != nil {
+		return
+	}
+
+	res = key
+	if res.Contributions, sysError = u.trusteeRepo.GetKeyContributions(ctx, electionID); sysError != nil {
+		return
+	}
+	if len(res.Contributions) == key.TrusteeCount {
+		sysError = u.trusteeRepo.CompleteElectionKey(ctx, electionID, now)
+		res.CompletedAt = &now
+	}
+	return
+}
+
+// GetMyShare - Share milik trustee yang login untuk setiap kontribusi, terenkripsi ke public key-nya.
+// Trustee membukanya di perangkat sendiri (X25519 + HKDF-SHA256 + AES-256-GCM dengan context yang dikembalikan)
+func (u *TrusteeUsecase) GetMyShare(ctx fiber.Ctx, electionID int) (res dto.TrusteeShareResponse, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	trustee, sysError := u.trusteeRepo.GetTrusteeByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+
+	key, sysError := u.getCeremonyKey(ctx, electionID, true)
+	if sysError != nil {
+		return
+	}
+	if key.CompletedAt == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini belum selesai")
+		return
+	}
+
+	shares, sysError := u.trusteeRepo.GetTrusteeKeyShares(ctx, trustee.ID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.TrusteeShareResponse{
+		ElectionID:   electionID,
+		TrusteeID:    trustee.ID,
+		Position:     trustee.Position,
+		Threshold:    key.Threshold,
+		TrusteeCount: key.TrusteeCount,
+		Shares:       make([]dto.TrusteeShare, 0, len(shares)),
+	}
+	for _, share := range shares {
+		res.Shares = append(res.Shares, dto.TrusteeShare{
+			ContributionID: share.ContributionID,
+			EncryptedShare: share.EncryptedShare,
+			Context:        share.Context,
+		})
+	}
+	return
+}
+
+// SubmitShare - Trustee mengirim seluruh share yang sudah dibuka, hanya diterima setelah election ditutup
+// dan setiap share harus sama dengan share yang dibagikan saat key ceremony
+func (u *TrusteeUsecase) SubmitShare(ctx fiber.Ctx, electionID int, req dto.SubmitShareRequest) (res entity.Trustee, sysError syserror.SysError) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusUnauthorized, "User tidak terautentikasi")
+		return
+	}
+
+	submitted := make(map[int][]byte, len(req.Shares))
+	for _, share := range req.Shares {
+		decoded, err := base64.StdEncoding.DecodeString(share.Share)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusBadRequest, "Share harus berformat base64")
+			return
+		}
+		submitted[share.ContributionID] = decoded
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureClosedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	key, sysError := u.getCeremonyKey(ctx, electionID, true)
+	if sysError != nil {
+		return
+	}
+	if key.DecryptedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Ballot election sudah didekripsi")
+		return
+	}
+
+	trustee, sysError := u.trusteeRepo.GetTrusteeByUserID(ctx, electionID, userID)
+	if sysError != nil {
+		return
+	}
+	if trustee.SubmittedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Share sudah dikirim")
+		return
+	}
+
+	owned, sysError := u.trusteeRepo.GetTrusteeKeyShares(ctx, trustee.ID)
+	if sysError != nil {
+		return
+	}
+	if len(owned) == 0 || len(submitted) != len(owned) || len(req.Shares) != len(owned) {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Share harus dikirim tepat satu untuk setiap kontribusi")
+		return
+	}
+	for _, share := range owned {
+		decoded, ok := submitted[share.ContributionID]
+		if !ok {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "Share harus dikirim tepat satu untuk setiap kontribusi")
+			return
+		}
+		if hashShare(decoded) != share.ShareHash {
+			sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest,
+				fmt.Sprintf("Share kontribusi %d tidak sesuai dengan share yang dibagikan saat key ceremony", share.ContributionID))
+			return
+		}
+		if sysError = u.trusteeRepo.SubmitKeyShare(ctx, share.ContributionID, trustee.ID, base64.StdEncoding.EncodeToString(decoded)); sysError != nil {
+			return
+		}
+	}
+
+	res, sysError = u.trusteeRepo.MarkTrusteeSubmitted(ctx, trustee.ID, helper.Now())
+	return
+}
+
+// DecryptElection - Rekonstruksi private key setiap kontribusi dari share trustee yang masuk (admin organization).
+// Setelah berhasil private key dipublikasikan bersama public key sehingga siapa pun bisa memverifikasi penghitungan
+func (u *TrusteeUsecase) DecryptElection(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusClosed && election.Status != electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Ballot hanya dapat didekripsi setelah election ditutup")
+		return
+	}
+
+	key, sysError := u.getCeremonyKey(ctx, electionID, true)
+	if sysError != nil {
+		return
+	}
+	if key.DecryptedAt != nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Ballot election sudah didekripsi")
+		return
+	}
+	if key.CompletedAt == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini belum selesai")
+		return
+	}
+
+	trustees, sysError := u.trusteeRepo.GetTrustees(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	submittedCount := 0
+	for _, trustee := range trustees {
+		if trustee.SubmittedAt != nil {
+			submittedCount++
+		}
+	}
+	if submittedCount < key.Threshold {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict,
+			fmt.Sprintf("Baru %d dari minimal %d trustee yang mengirim share", submittedCount, key.Threshold))
+		return
+	}
+
+	contributions, sysError := u.trusteeRepo.GetKeyContributions(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	submitted, sysError := u.trusteeRepo.GetSubmittedKeyShares(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	shares := make(map[int][][]byte, len(contributions))
+	for _, share := range submitted {
+		decoded, err := base64.StdEncoding.DecodeString(*share.Share)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Share trustee rusak")
+			return
+		}
+		shares[share.ContributionID] = append(shares[share.ContributionID], decoded)
+	}
+
+	for _, contribution := range contributions {
+		privateBytes, err := shamir.Combine(shares[contribution.ID])
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyusun kunci election")
+			return
+		}
+		privateKey, err := electioncrypto.ParsePrivateKey(privateBytes)
+		if err != nil || electioncrypto.EncodeKey(privateKey.PublicKey().Bytes()) != contribution.PublicKey {
+			sysError = syserror.CreateError(fmt.Errorf("reconstructed key mismatch"), fiber.StatusInternalServerError,
+				fmt.Sprintf("Share trustee tidak menghasilkan kunci kontribusi %d", contribution.ID))
+			return
+		}
+
+		if sysError = u.trusteeRepo.UpdateContributionPrivateKey(ctx, contribution.ID, electioncrypto.EncodeKey(privateBytes)); sysError != nil {
+			return
+		}
+	}
+
+	if res, sysError = u.trusteeRepo.MarkElectionDecrypted(ctx, electionID, helper.Now()); sysError != nil {
+		return
+	}
+	res.Contributions, sysError = u.trusteeRepo.GetKeyContributions(ctx, electionID)
+	return
+}
+
+// GetElectionKey - Public key setiap kontribusi, threshold dan status key ceremony serta dekripsi (publik)
+func (u *TrusteeUsecase) GetElectionKey(ctx fiber.Ctx, electionID int) (res entity.ElectionKey, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetElectionByID(ctx, electionID); sysError != nil {
+		return
+	}
+
+	if res, sysError = u.trusteeRepo.GetElectionKey(ctx, electionID); sysError != nil {
+		return
+	}
+	res.Contributions, sysError = u.trusteeRepo.GetKeyContributions(ctx, electionID)
+	return
+}
+
+// EncryptBallot - Enkripsi isi ballot berlapis ke public key seluruh kontribusi. encrypted false berarti
+// election tidak memakai ballot terenkripsi sehingga content disimpan apa adanya.
+// Enkripsi dilakukan server saat suara diterima, jadi yang terlindungi adalah ballot yang tersimpan:
+// proses yang sedang menerima suara tetap melihat isi ballot sebelum dienkripsi
+func (u *TrusteeUsecase) EncryptBallot(ctx fiber.Ctx, electionID int, content []byte) (ciphertext string, encrypted bool, sysError syserror.SysError) {
+	key, sysError := u.trusteeRepo.GetElectionKey(ctx, electionID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = nil
+		}
+		return
+	}
+	if key.CompletedAt == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election belum selesai, ballot belum bisa dienkripsi")
+		return
+	}
+
+	contributions, sysError := u.trusteeRepo.GetKeyContributions(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	publicKeys := make([]*ecdh.PublicKey, len(contributions))
+	for i, contribution := range contributions {
+		publicKey, err := electioncrypto.ParsePublicKey(contribution.PublicKey)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Kunci election tidak valid")
+			return
+		}
+		publicKeys[i] = publicKey
+	}
+
+	// spasi di akhir JSON tidak mengubah isi ballot saat dibaca kembali
+	padded := content
+	if rest := len(content) % ballotPadding; rest != 0 {
+		padded = append(bytes.Clone(content), bytes.Repeat([]byte(" "), ballotPadding-rest)...)
+	}
+
+	sealed, err := electioncrypto.EncryptLayers(publicKeys, padded, ballotContext(electionID))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal mengenkripsi ballot")
+		return
+	}
+	return base64.StdEncoding.EncodeToString(sealed), true, nil
+}
+
+// DecryptBallots - Buka isi ballot terenkripsi dengan private key kontribusi hasil rekonstruksi trustee
+func (u *TrusteeUsecase) DecryptBallots(ctx fiber.Ctx, electionID int, ciphertexts []string) (res [][]byte, sysError syserror.SysError) {
+	key, sysError := u.trusteeRepo.GetElectionKey(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if key.DecryptedAt == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Ballot terenkripsi belum didekripsi oleh trustee")
+		return
+	}
+
+	contributions, sysError := u.trusteeRepo.GetKeyContributions(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	privateKeys := make([]*ecdh.PrivateKey, len(contributions))
+	for i, contribution := range contributions {
+		if contribution.PrivateKey == nil {
+			sysError = syserror.CreateError(fmt.Errorf("contribution %d not decrypted", contribution.ID), fiber.StatusInternalServerError, "Kunci election tidak valid")
+			return
+		}
+		privateBytes, err := base64.StdEncoding.DecodeString(*contribution.PrivateKey)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Kunci election tidak valid")
+			return
+		}
+		if privateKeys[i], err = electioncrypto.ParsePrivateKey(privateBytes); err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Kunci election tidak valid")
+			return
+		}
+	}
+
+	// ballot yang tidak bisa dibuka dibiarkan kosong dan dihitung sebagai tidak valid
+	res = make([][]byte, len(ciphertexts))
+	for i, ciphertext := range ciphertexts {
+		sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+		if err != nil {
+			continue
+		}
+		res[i], _ = electioncrypto.DecryptLayers(privateKeys, sealed, ballotContext(electionID))
+	}
+	return
+}
+
+// ensureBeforeCeremony - Kunci election, pastikan user admin dan key ceremony belum dilakukan.
+// Trustee dan kunci hanya dapat diatur sebelum election dibuka
+func (u *TrusteeUsecase) ensureBeforeCeremony(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusDraft && election.Status != electionEntity.StatusScheduled {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Trustee hanya dapat diatur sebelum election dibuka")
+		return
+	}
+
+	_, sysError = u.getCeremonyKey(ctx, electionID, false)
+	return
+}
+
+// ensureClosedElection - Pastikan pemungutan suara sudah selesai
+func (u *TrusteeUsecase) ensureClosedElection(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetElectionByIDForShare(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusClosed && election.Status != electionEntity.StatusPublished {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Share hanya dapat dikirim setelah election ditutup")
+	}
+	return
+}
+
+// getCeremonyKey - Ambil kunci election dan cek apakah key ceremony sudah (done true) atau belum dilakukan
+func (u *TrusteeUsecase) getCeremonyKey(ctx fiber.Ctx, electionID int, done bool) (key entity.ElectionKey, sysError syserror.SysError) {
+	key, sysError = u.trusteeRepo.GetElectionKey(ctx, electionID)
+	switch {
+	case sysError == nil && !done:
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini sudah dilakukan")
+	case sysError != nil && sysError.GetStatusCode() == fiber.StatusNotFound:
+		if done {
+			sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Key ceremony election ini belum dilakukan")
+		} else {
+			sysError = nil
+		}
+	}
+	return
+}
+
+// shareContext - context enkripsi share kontribusi dealerID untuk trustee penerima
+func shareContext(electionID int, dealerID int, trusteeID int) string {
+	return fmt.Sprintf("election:%d:dealer:%d:trustee:%d", electionID, dealerID, trusteeID)
+}
+
+func ballotContext(electionID int) string {
+	return fmt.Sprintf("election:%d", electionID)
+}
+
+func hashShare(share []byte) string {
+	sum := sha256.Sum256(share)
+	return hex.EncodeToString(sum[:])
+}
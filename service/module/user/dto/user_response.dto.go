@@ -9,5 +9,6 @@ type GetUserResponse struct {
 	Email          string               `json:"email"`
 	Age            int                  `json:"age"`
 	OrganizationID int                  `json:"organization_id"`
+	MemberNumber   *string              `json:"member_number"`
 	Organization   *entity.Organization `json:"organization"`
 }
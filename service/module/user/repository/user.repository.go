@@ -14,11 +14,11 @@ func (r *UserRepository) CreateUser(ctx fiber.Ctx, user entity.User) (res entity
 		Ctx: ctx.Context(),
 	}
 
-	query := `INSERT INTO public.users (name, short_name, email, age, password, organization_id) 
-             VALUES ($1, $2, $3, $4, $5, $6) 
-             RETURNING id, name, short_name, email, age, password, organization_id`
+	query := `INSERT INTO public.users (name, short_name, email, age, password, organization_id, member_number, created_at) 
+             VALUES ($1, $2, $3, $4, $5, $6, $7, $8) 
+             RETURNING id, name, short_name, email, age, password, organization_id, member_number`
 
-	model := db.Get(&res, query, user.Name, user.ShortName, user.Email, user.Age, user.Password, user.OrganizationID)
+	model := db.Get(&res, query, user.Name, user.ShortName, user.Email, user.Age, user.Password, user.OrganizationID, user.MemberNumber, helper.Now())
 	if model != nil {
 		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal membuat user")
 		return
@@ -31,7 +31,7 @@ func (r *UserRepository) GetUserByID(ctx fiber.Ctx, Id string) (res entity.User,
 		DB:  r.mainDB.DB,
 		Ctx: ctx.Context(),
 	}
-	query := `SELECT id, name, short_name, email, age, password, organization_id FROM public.users WHERE id = $1`
+	query := `SELECT id, name, short_name, email, age, password, organization_id, member_number FROM public.users WHERE id = $1`
 
 	model := db.Get(&res, query, Id)
 	if model != nil {
@@ -62,7 +62,7 @@ func (r *UserRepository) GetUsers(ctx fiber.Ctx) (res []entity.User, totalRecord
 	}
 
 	// Get paginated data
-	query := `SELECT id, name, short_name, email, age, password, organization_id 
+	query := `SELECT id, name, short_name, email, age, password, organization_id, member_number 
 	          FROM public.users 
 	          LIMIT $1 OFFSET $2`
 
@@ -101,10 +101,10 @@ func (r *UserRepository) UpdateUser(ctx fiber.Ctx, Id string, user entity.User)
 	}
 
 	query := `UPDATE public.users 
-			 SET name = $1, short_name = $2, email = $3, age = $4, password = $5, organization_id = $6 
-			 WHERE id = $7 
-			 RETURNING id, name, short_name, email, age, password, organization_id`
-	model := db.Get(&res, query, user.Name, user.ShortName, user.Email, user.Age, user.Password, user.OrganizationID, Id)
+			 SET name = $1, short_name = $2, email = $3, age = $4, password = $5, organization_id = $6, member_number = $7 
+			 WHERE id = $8 
+			 RETURNING id, name, short_name, email, age, password, organization_id, member_number`
+	model := db.Get(&res, query, user.Name, user.ShortName, user.Email, user.Age, user.Password, user.OrganizationID, user.MemberNumber, Id)
 	if model != nil {
 		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal memperbarui user")
 		return
@@ -123,7 +123,7 @@ func (r *UserRepository) UpdateProfileUser(ctx fiber.Ctx, Id string, user entity
 	query := `UPDATE public.users 
 			 SET name = $1, short_name = $2, email = $3, age = $4 
 			 WHERE id = $5 
-			 RETURNING id, name, short_name, email, age, password, organization_id`
+			 RETURNING id, name, short_name, email, age, password, organization_id, member_number`
 	model := db.Get(&res, query, user.Name, user.ShortName, user.Email, user.Age, Id)
 	if model != nil {
 		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal memperbarui profil user")
@@ -153,7 +153,7 @@ func (r *UserRepository) GetUsersByOrganizationID(ctx fiber.Ctx, organizationID
 	}
 
 	// Get paginated data
-	query := `SELECT id, name, short_name, email, age, password, organization_id 
+	query := `SELECT id, name, short_name, email, age, password, organization_id, member_number 
 	          FROM public.users 
 	          WHERE organization_id = $1 
 	          LIMIT $2 OFFSET $3`
@@ -173,7 +173,7 @@ func (r *UserRepository) GetUserByEmailAndId(ctx fiber.Ctx, email string, Id str
 		DB:  r.mainDB.DB,
 		Ctx: ctx.Context(),
 	}
-	query := `SELECT id, name, short_name, email, age, password, organization_id FROM public.users WHERE email = $1 AND id != $2`
+	query := `SELECT id, name, short_name, email, age, password, organization_id, member_number FROM public.users WHERE email = $1 AND id != $2`
 
 	model := db.Get(&res, query, email, Id)
 	if model != nil {
@@ -190,7 +190,7 @@ func (r *UserRepository) GetUserByEmail(ctx fiber.Ctx, email string) (res entity
 		DB:  r.mainDB.DB,
 		Ctx: ctx.Context(),
 	}
-	query := `SELECT id, name, short_name, email, age, password, organization_id FROM public.users WHERE email = $1`
+	query := `SELECT id, name, short_name, email, age, password, organization_id, member_number FROM public.users WHERE email = $1`
 
 	model := db.Get(&res, query, email)
 	if model != nil {
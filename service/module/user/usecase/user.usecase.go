@@ -18,7 +18,7 @@ func (u *UserUsecase) CreateUser(ctx fiber.Ctx, req dto.CreateUserRequest) (res
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -53,6 +53,7 @@ func (u *UserUsecase) CreateUser(ctx fiber.Ctx, req dto.CreateUserRequest) (res
 		Age:            req.Age,
 		Password:       string(hashedPassword),
 		OrganizationID: req.OrganizationID,
+		MemberNumber:   req.MemberNumber,
 	})
 	if repoErr != nil {
 		sysError = repoErr
@@ -67,6 +68,7 @@ func (u *UserUsecase) CreateUser(ctx fiber.Ctx, req dto.CreateUserRequest) (res
 		Email:          model.Email,
 		Age:            model.Age,
 		OrganizationID: model.OrganizationID,
+		MemberNumber:   model.MemberNumber,
 	}
 
 	// Fetch organization if exists
@@ -94,6 +96,7 @@ func (u *UserUsecase) GetUserByID(ctx fiber.Ctx, Id string) (res dto.GetUserResp
 		Email:          user.Email,
 		Age:            user.Age,
 		OrganizationID: user.OrganizationID,
+		MemberNumber:   user.MemberNumber,
 	}
 
 	// Fetch organization if exists
@@ -121,6 +124,7 @@ func (u *UserUsecase) GetUsers(ctx fiber.Ctx) (res []dto.GetUserResponse, totalR
 			Email:          user.Email,
 			Age:            user.Age,
 			OrganizationID: user.OrganizationID,
+			MemberNumber:   user.MemberNumber,
 		}
 
 		// Fetch organization if exists
@@ -145,7 +149,7 @@ func (u *UserUsecase) DeleteUser(ctx fiber.Ctx, Id string) (sysError syserror.Sy
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -163,7 +167,7 @@ func (u *UserUsecase) UpdateUser(ctx fiber.Ctx, Id string, req dto.CreateUserReq
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -178,6 +182,7 @@ func (u *UserUsecase) UpdateUser(ctx fiber.Ctx, Id string, req dto.CreateUserReq
 		Age:            req.Age,
 		Password:       req.Password,
 		OrganizationID: req.OrganizationID,
+		MemberNumber:   req.MemberNumber,
 	})
 	if repoErr != nil {
 		sysError = repoErr
@@ -191,6 +196,7 @@ func (u *UserUsecase) UpdateUser(ctx fiber.Ctx, Id string, req dto.CreateUserReq
 		Email:          updatedUser.Email,
 		Age:            updatedUser.Age,
 		OrganizationID: updatedUser.OrganizationID,
+		MemberNumber:   updatedUser.MemberNumber,
 	}
 	// Fetch organization if exists
 	organization, orgErr := u.organizationUse.GetOrganizationByID(ctx, res.ID)
@@ -210,7 +216,7 @@ func (u *UserUsecase) UpdateProfileUser(ctx fiber.Ctx, Id string, req dto.Create
 		return
 	}
 	defer func() {
-		database.TxSubmitTerr(ctx, sysError)
+		sysError = database.TxSubmitTerr(ctx, sysError)
 	}()
 
 	if tx == nil {
@@ -238,6 +244,7 @@ func (u *UserUsecase) UpdateProfileUser(ctx fiber.Ctx, Id string, req dto.Create
 		Email:          updatedUser.Email,
 		Age:            updatedUser.Age,
 		OrganizationID: updatedUser.OrganizationID,
+		MemberNumber:   updatedUser.MemberNumber,
 	}
 	// Fetch organization if exists
 	organization, orgErr := u.organizationUse.GetOrganizationByID(ctx, res.ID)
@@ -265,6 +272,7 @@ func (u *UserUsecase) GetUsersByOrganizationID(ctx fiber.Ctx, organizationID str
 			Email:          user.Email,
 			Age:            user.Age,
 			OrganizationID: user.OrganizationID,
+			MemberNumber:   user.MemberNumber,
 		}
 
 		// Fetch organization if exists
@@ -298,6 +306,7 @@ func (u *UserUsecase) GetUserByEmail(ctx fiber.Ctx, email string) (res dto.GetUs
 		Email:          user.Email,
 		Age:            user.Age,
 		OrganizationID: user.OrganizationID,
+		MemberNumber:   user.MemberNumber,
 	}
 
 	// Fetch organization if exists
@@ -324,6 +333,7 @@ func (u *UserUsecase) GetUserByEmailAndId(ctx fiber.Ctx, email string, Id string
 		Email:          user.Email,
 		Age:            user.Age,
 		OrganizationID: user.OrganizationID,
+		MemberNumber:   user.MemberNumber,
 	}
 
 	// Fetch organization if exists
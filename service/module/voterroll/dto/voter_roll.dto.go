@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+// ImportMembersRequest - DTO untuk mengisi DPT dari anggota organization, opsional dengan filter
+type ImportMembersRequest struct {
+	MinAge      int    `json:"min_age" validate:"omitempty,gte=0"`
+	MaxAge      int    `json:"max_age" validate:"omitempty,gte=0"`
+	EmailDomain string `json:"email_domain" validate:"omitempty,fqdn"`
+	DryRun      bool   `json:"dry_run"`
+}
+
+// UpdateWeightRequest - DTO untuk mengubah bobot suara satu pemilih DPT
+type UpdateWeightRequest struct {
+	Weight int `json:"weight" validate:"required,gt=0"`
+}
+
+// ImportReport - Ringkasan hasil (atau rencana, jika dry_run) pengisian DPT.
+// Unregistered adalah bagian dari Added yang dimasukkan tanpa akun user
+type ImportReport struct {
+	DryRun        bool             `json:"dry_run"`
+	TotalRows     int              `json:"total_rows"`
+	Matched       int              `json:"matched"`
+	Added         int              `json:"added"`
+	AlreadyOnRoll int              `json:"already_on_roll"`
+	Unregistered  int              `json:"unregistered"`
+	Unmatched     []ImportRowIssue `json:"unmatched"`
+	Duplicates    []ImportRowIssue `json:"duplicates"`
+}
+
+// ImportRowIssue - Baris CSV yang tidak bisa dimasukkan ke DPT
+type ImportRowIssue struct {
+	Row    int    `json:"row"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// EligibilityRulesRequest - DTO aturan hak pilih election. organization_ids harus organization penyelenggara
+// atau turunannya, role dicocokkan dengan role user di organization tersebut
+type EligibilityRulesRequest struct {
+	MinAge                    int                `json:"min_age" validate:"gte=0"`
+	Roles                     []string           `json:"roles" validate:"omitempty,dive,required,max=50"`
+	OrganizationIDs           []int              `json:"organization_ids" validate:"omitempty,dive,gt=0"`
+	IncludeChildOrganizations bool               `json:"include_child_organizations"`
+	RegisteredBefore          *helper.CustomTime `json:"registered_before"`
+}
+
+// EvaluateRulesRequest - dry_run hanya mengembalikan perbedaan tanpa mengubah DPT
+type EvaluateRulesRequest struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// EligibilityDiff - Perbedaan DPT dengan hasil evaluasi aturan hak pilih.
+// NotEligible adalah entri yang ditambahkan manual (anggota, filter, CSV) dan tidak memenuhi aturan,
+// entri ini tidak dihapus otomatis dan dilaporkan supaya ditinjau admin
+type EligibilityDiff struct {
+	DryRun      bool               `json:"dry_run"`
+	Eligible    int                `json:"eligible"`
+	Unchanged   int                `json:"unchanged"`
+	Added       []entity.Member    `json:"added"`
+	Removed     []entity.VoterRoll `json:"removed"`
+	NotEligible []entity.VoterRoll `json:"not_eligible"`
+}
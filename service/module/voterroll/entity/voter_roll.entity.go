@@ -0,0 +1,58 @@
+package entity
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+const (
+	SourceMember = "member"
+	SourceFilter = "filter"
+	SourceCSV    = "csv"
+	SourceRules  = "rules" // hasil evaluasi aturan hak pilih, dihapus otomatis jika tidak lagi memenuhi aturan
+)
+
+// VoterRoll - Satu entri daftar pemilih tetap (DPT) election.
+// UserID kosong untuk pemilih tanpa akun yang memilih memakai kode voting.
+// Weight adalah jumlah suara yang dimiliki pemilih, 1 untuk satu orang satu suara
+type VoterRoll struct {
+	ID           int               `db:"id" json:"id"`
+	ElectionID   int               `db:"election_id" json:"election_id"`
+	UserID       *int              `db:"user_id" json:"user_id"`
+	Name         string            `db:"name" json:"name"`
+	Email        string            `db:"email" json:"email"`
+	MemberNumber *string           `db:"member_number" json:"member_number"`
+	Source       string            `db:"source" json:"source"`
+	Weight       int               `db:"weight" json:"weight"`
+	CreatedAt    helper.CustomTime `db:"created_at" json:"created_at"`
+}
+
+func (VoterRoll) TableName() string {
+	return "voter_rolls"
+}
+
+// Member - Anggota organization yang bisa dimasukkan ke DPT
+type Member struct {
+	UserID       int     `db:"user_id" json:"user_id"`
+	Name         string  `db:"name" json:"name"`
+	Email        string  `db:"email" json:"email"`
+	MemberNumber *string `db:"member_number" json:"member_number"`
+}
+
+// MemberFilter - Filter anggota organization, nilai kosong berarti tidak difilter
+type MemberFilter struct {
+	MinAge      int
+	MaxAge      int
+	EmailDomain string
+}
+
+func (f MemberFilter) IsEmpty() bool {
+	return f.MinAge == 0 && f.MaxAge == 0 && f.EmailDomain == ""
+}
+
+// EligibilityRules - Aturan hak pilih yang disimpan bersama election. Semua aturan yang diisi harus
+// terpenuhi, nilai kosong berarti aturan tersebut tidak dipakai
+type EligibilityRules struct {
+	MinAge                    int                `json:"min_age"`
+	Roles                     []string           `json:"roles"`                       // memiliki salah satu role ini
+	OrganizationIDs           []int              `json:"organization_ids"`            // anggota organization ini, kosong berarti organization penyelenggara
+	IncludeChildOrganizations bool               `json:"include_child_organizations"` // termasuk anggota seluruh organization turunannya
+	RegisteredBefore          *helper.CustomTime `json:"registered_before"`           // akun user dibuat sebelum waktu ini
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+
+type VoterRollHandler struct {
+	VoterRollUsecase usecase.IVoterRollUsecase
+}
+
+func InitVoterRollHandler(voterRollUsecase usecase.IVoterRollUsecase) *VoterRollHandler {
+	return &VoterRollHandler{
+		VoterRollUsecase: voterRollUsecase,
+	}
+}
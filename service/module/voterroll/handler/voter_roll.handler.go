@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/dto"
+)
+
+// GetVoterRolls - DPT election, bisa dicari dengan ?search=
+// @GET /elections/:id/voter-rolls
+func (h *VoterRollHandler) GetVoterRolls(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	res, totalRecords, sysErr := h.VoterRollUsecase.GetVoterRolls(ctx, electionID, ctx.Query("search"))
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendPaginatedResponse(ctx, fiber.StatusOK, "Voter rolls retrieved successfully",
+		pagination.Page, pagination.PageSize, totalRecords, res)
+}
+
+// ImportMembers - Isi DPT dari anggota organization
+// @POST /elections/:id/voter-rolls/members
+// Body: {min_age?: int, max_age?: int, email_domain?: string, dry_run?: bool}
+func (h *VoterRollHandler) ImportMembers(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.ImportMembersRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VoterRollUsecase.ImportMembers(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Voter roll import processed successfully", res)
+}
+
+// ImportCSV - Isi DPT dari file CSV (kolom email dan/atau member_number, name dan weight opsional)
+// @POST /elections/:id/voter-rolls/csv
+// Form: file (multipart), dry_run (true/false), include_unregistered (true/false)
+func (h *VoterRollHandler) ImportCSV(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "File CSV wajib diunggah", err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Gagal membaca file CSV", err)
+	}
+	defer file.Close()
+
+	dryRun, _ := strconv.ParseBool(ctx.FormValue("dry_run"))
+	includeUnregistered, _ := strconv.ParseBool(ctx.FormValue("include_unregistered"))
+
+	res, sysErr := h.VoterRollUsecase.ImportCSV(ctx, electionID, file, dryRun, includeUnregistered)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Voter roll import processed successfully", res)
+}
+
+// UpdateVoterRollWeight - Ubah bobot suara pemilih DPT
+// @PUT /elections/:id/voter-rolls/:voter_roll_id/weight
+// Body: {weight: int}
+func (h *VoterRollHandler) UpdateVoterRollWeight(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("voter_roll_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid voter roll ID", err)
+	}
+
+	var req dto.UpdateWeightRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VoterRollUsecase.UpdateVoterRollWeight(ctx, electionID, id, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Voter weight updated successfully", res)
+}
+
+// DeleteVoterRoll - Hapus pemilih dari DPT
+// @DELETE /elections/:id/voter-rolls/:voter_roll_id
+func (h *VoterRollHandler) DeleteVoterRoll(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+	id, err := strconv.Atoi(ctx.Params("voter_roll_id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid voter roll ID", err)
+	}
+
+	sysErr := h.VoterRollUsecase.DeleteVoterRoll(ctx, electionID, id)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Voter roll entry deleted successfully", nil)
+}
+
+// GetEligibilityRules - Aturan hak pilih election
+// @GET /elections/:id/voter-rolls/rules
+func (h *VoterRollHandler) GetEligibilityRules(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	res, sysErr := h.VoterRollUsecase.GetEligibilityRules(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Eligibility rules retrieved successfully", res)
+}
+
+// UpdateEligibilityRules - Simpan aturan hak pilih election
+// @PUT /elections/:id/voter-rolls/rules
+// Body: {min_age?: int, roles?: []string, organization_ids?: []int, include_child_organizations?: bool, registered_before?: string}
+func (h *VoterRollHandler) UpdateEligibilityRules(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.EligibilityRulesRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VoterRollUsecase.UpdateEligibilityRules(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Eligibility rules updated successfully", res)
+}
+
+// EvaluateEligibilityRules - Evaluasi aturan hak pilih dan terapkan perbedaannya ke DPT
+// @POST /elections/:id/voter-rolls/rules/evaluate
+// Body: {dry_run?: bool}
+func (h *VoterRollHandler) EvaluateEligibilityRules(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.EvaluateRulesRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VoterRollUsecase.EvaluateEligibilityRules(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Eligibility rules evaluated successfully", res)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+type VoterRollRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitVoterRollRepository(mainDB *database.MainDB) IVoterRollRepository {
+	return &VoterRollRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *VoterRollRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IVoterRollRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetVoterRolls(ctx fiber.Ctx, electionID int, search string) (res []entity.VoterRoll, totalRecords int64, sysError syserror.SysError)
+	GetVoterRollByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollByID(ctx fiber.Ctx, electionID int, id int) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollByIdentity(ctx fiber.Ctx, electionID int, identity string) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.VoterRoll, sysError syserror.SysError)
+	GetRolledUserIDs(ctx fiber.Ctx, electionID int) (res []int, sysError syserror.SysError)
+	GetMembers(ctx fiber.Ctx, organizationID int, filter entity.MemberFilter) (res []entity.Member, sysError syserror.SysError)
+	CreateVoterRolls(ctx fiber.Ctx, electionID int, userIDs []int, weights []int, source string) (added int64, sysError syserror.SysError)
+	CreateUnregisteredVoterRolls(ctx fiber.Ctx, electionID int, voters []entity.VoterRoll, source string) (added int64, sysError syserror.SysError)
+	UpdateVoterRollWeight(ctx fiber.Ctx, electionID int, id int, weight int) (res entity.VoterRoll, sysError syserror.SysError)
+	DeleteVoterRoll(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+	DeleteVoterRolls(ctx fiber.Ctx, electionID int, ids []int) (deleted int64, sysError syserror.SysError)
+
+	GetEligibilityRules(ctx fiber.Ctx, electionID int) (rules *string, sysError syserror.SysError)
+	UpdateEligibilityRules(ctx fiber.Ctx, electionID int, rules string) (sysError syserror.SysError)
+	GetEligibleMembers(ctx fiber.Ctx, organizationIDs []int, rules entity.EligibilityRules) (res []entity.Member, sysError syserror.SysError)
+}
@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+const voterRollColumns = `id, election_id, user_id, name, email, member_number, source, weight, created_at`
+
+// GetVoterRolls - DPT election, bisa dicari berdasarkan nama, email atau nomor anggota
+func (r *VoterRollRepository) GetVoterRolls(ctx fiber.Ctx, electionID int, search string) (res []entity.VoterRoll, totalRecords int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	// Parse pagination
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	offset := helper.GetOffset(pagination.Page, pagination.PageSize)
+
+	filter := ` WHERE election_id = $1 AND ($2 = '' OR name ILIKE '%' || $2 || '%' OR email ILIKE '%' || $2 || '%' OR member_number = $2)`
+
+	model := db.Get(&totalRecords, `SELECT COUNT(*) FROM public.voter_rolls`+filter, electionID, search)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil total records")
+		return
+	}
+
+	query := `SELECT ` + voterRollColumns + ` FROM public.voter_rolls` + filter + ` ORDER BY name, id LIMIT $3 OFFSET $4`
+	model = db.Select(&res, query, electionID, search, pagination.PageSize, offset)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+		return
+	}
+	return
+}
+
+func (r *VoterRollRepository) GetVoterRollByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.VoterRoll, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + voterRollColumns + ` FROM public.voter_rolls WHERE election_id = $1 AND user_id = $2`
+
+	model := db.Get(&res, query, electionID, userID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Pemilih tidak terdaftar dalam DPT")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+	}
+	return
+}
+
+func (r *VoterRollRepository) GetVoterRollByID(ctx fiber.Ctx, electionID int, id int) (res entity.VoterRoll, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + voterRollColumns + ` FROM public.voter_rolls WHERE id = $1 AND election_id = $2`
+
+	model := db.Get(&res, query, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Entri DPT tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+	}
+	return
+}
+
+// GetVoterRollByIdentity - Entri DPT berdasarkan email (jika mengandung @) atau nomor anggota
+func (r *VoterRollRepository) GetVoterRollByIdentity(ctx fiber.Ctx, electionID int, identity string) (res entity.VoterRoll, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + voterRollColumns + ` FROM public.voter_rolls WHERE election_id = $1 AND member_number = $2`
+	if strings.Contains(identity, "@") {
+		query = `SELECT ` + voterRollColumns + ` FROM public.voter_rolls WHERE election_id = $1 AND LOWER(email) = LOWER($2)`
+	}
+
+	model := db.Get(&res, query, electionID, identity)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Pemilih tidak terdaftar dalam DPT")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+	}
+	return
+}
+
+// GetVoterRollsByElectionID - Seluruh entri DPT election tanpa pagination
+func (r *VoterRollRepository) GetVoterRollsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.VoterRoll, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + voterRollColumns + ` FROM public.voter_rolls WHERE election_id = $1 ORDER BY id`
+
+	model := db.Select(&res, query, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+		return
+	}
+	return
+}
+
+func (r *VoterRollRepository) GetRolledUserIDs(ctx fiber.Ctx, electionID int) (res []int, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	model := db.Select(&res, `SELECT user_id FROM public.voter_rolls WHERE election_id = $1 AND user_id IS NOT NULL`, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+		return
+	}
+	return
+}
+
+// GetMembers - Anggota organization sesuai filter umur dan domain email
+func (r *VoterRollRepository) GetMembers(ctx fiber.Ctx, organizationID int, filter entity.MemberFilter) (res []entity.Member, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT id AS user_id, name, email, member_number
+	          FROM public.users
+	          WHERE organization_id = $1
+	            AND ($2 = 0 OR age >= $2)
+	            AND ($3 = 0 OR age <= $3)
+	            AND ($4 = '' OR LOWER(email) LIKE '%@' || LOWER($4))
+	          ORDER BY id`
+
+	model := db.Select(&res, query, organizationID, filter.MinAge, filter.MaxAge, filter.EmailDomain)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil anggota organization")
+		return
+	}
+	return
+}
+
+// CreateVoterRolls - Masukkan banyak user ke DPT sekaligus beserta bobot suaranya (sejajar dengan userIDs),
+// user yang sudah terdaftar dilewati
+func (r *VoterRollRepository) CreateVoterRolls(ctx fiber.Ctx, electionID int, userIDs []int, weights []int, source string) (added int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `INSERT INTO public.voter_rolls (election_id, user_id, name, email, member_number, source, weight, created_at)
+	          SELECT $1, u.id, u.name, u.email, u.member_number, $2, v.weight, $3
+	          FROM UNNEST($4::int[], $5::int[]) AS v(user_id, weight)
+	          JOIN public.users u ON u.id = v.user_id
+	          ON CONFLICT DO NOTHING`
+
+	result, err := db.Exec(query, electionID, source, helper.Now(), pq.Array(userIDs), pq.Array(weights))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menambahkan DPT")
+		return
+	}
+	added, _ = result.RowsAffected()
+	return
+}
+
+// CreateUnregisteredVoterRolls - Masukkan pemilih tanpa akun ke DPT, email atau nomor anggota
+// yang sudah terdaftar di election dilewati
+func (r *VoterRollRepository) CreateUnregisteredVoterRolls(ctx fiber.Ctx, electionID int, voters []entity.VoterRoll, source string) (added int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	names := make([]string, len(voters))
+	emails := make([]string, len(voters))
+	memberNumbers := make([]string, len(voters))
+	weights := make([]int, len(voters))
+	for i, voter := range voters {
+		names[i] = voter.Name
+		emails[i] = voter.Email
+		weights[i] = max(voter.Weight, 1)
+		if voter.MemberNumber != nil {
+			memberNumbers[i] = *voter.MemberNumber
+		}
+	}
+
+	query := `INSERT INTO public.voter_rolls (election_id, name, email, member_number, source, weight, created_at)
+	          SELECT $1, v.name, v.email, NULLIF(v.member_number, ''), $2, v.weight, $3
+	          FROM UNNEST($4::text[], $5::text[], $6::text[], $7::int[]) AS v(name, email, member_number, weight)
+	          ON CONFLICT DO NOTHING`
+
+	result, err := db.Exec(query, electionID, source, helper.Now(), pq.Array(names), pq.Array(emails), pq.Array(memberNumbers), pq.Array(weights))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menambahkan DPT")
+		return
+	}
+	added, _ = result.RowsAffected()
+	return
+}
+
+// UpdateVoterRollWeight - Ubah bobot suara satu entri DPT
+func (r *VoterRollRepository) UpdateVoterRollWeight(ctx fiber.Ctx, electionID int, id int, weight int) (res entity.VoterRoll, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.voter_rolls SET weight = $1 WHERE id = $2 AND election_id = $3 RETURNING ` + voterRollColumns
+
+	model := db.Get(&res, query, weight, id, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Entri DPT tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengubah bobot suara")
+	}
+	return
+}
+
+// DeleteVoterRoll - Hapus satu entri DPT
+func (r *VoterRollRepository) DeleteVoterRoll(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.voter_rolls WHERE id = $1 AND election_id = $2`
+
+	result, err := db.Exec(query, id, electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus DPT")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Entri DPT tidak ditemukan")
+		return
+	}
+
+	return
+}
+
+// DeleteVoterRolls - Hapus banyak entri DPT sekaligus
+func (r *VoterRollRepository) DeleteVoterRolls(ctx fiber.Ctx, electionID int, ids []int) (deleted int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `DELETE FROM public.voter_rolls WHERE election_id = $1 AND id = ANY($2::int[])`
+
+	result, err := db.Exec(query, electionID, pq.Array(ids))
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menghapus DPT")
+		return
+	}
+	deleted, _ = result.RowsAffected()
+	return
+}
+
+// GetEligibilityRules - Aturan hak pilih yang disimpan pada election dalam bentuk JSON, nil jika belum diatur
+func (r *VoterRollRepository) GetEligibilityRules(ctx fiber.Ctx, electionID int) (rules *string, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT eligibility_rules::text FROM public.elections WHERE id = $1`
+
+	model := db.Get(&rules, query, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil aturan hak pilih")
+	}
+	return
+}
+
+func (r *VoterRollRepository) UpdateEligibilityRules(ctx fiber.Ctx, electionID int, rules string) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.elections SET eligibility_rules = $1::jsonb, updated_at = $2 WHERE id = $3`
+
+	result, err := db.Exec(query, rules, helper.Now(), electionID)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan aturan hak pilih")
+		return
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		sysError = syserror.CreateError(nil, fiber.StatusNotFound, "Election tidak ditemukan")
+		return
+	}
+	return
+}
+
+// GetEligibleMembers - Anggota organizationIDs yang memenuhi aturan umur, role dan waktu pendaftaran.
+// Role dicocokkan dengan role organization (bukan role per election seperti observer)
+func (r *VoterRollRepository) GetEligibleMembers(ctx fiber.Ctx, organizationIDs []int, rules entity.EligibilityRules) (res []entity.Member, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT u.id AS user_id, u.name, u.email, u.member_number
+	          FROM public.users u
+	          WHERE u.organization_id = ANY($1::int[])
+	            AND ($2 = 0 OR u.age >= $2)
+	            AND (cardinality($3::text[]) = 0 OR EXISTS (
+	                SELECT 1 FROM public.users_has_roles uhr
+	                JOIN public.roles r ON r.id = uhr.role_id
+	                WHERE uhr.user_id = u.id AND uhr.election_id IS NULL
+	                  AND uhr.organization_id = ANY($1::int[]) AND r.name = ANY($3::text[])
+	            ))
+	            AND ($4::timestamp IS NULL OR u.created_at < $4::timestamp)
+	          ORDER BY u.id`
+
+	model := db.Select(&res, query, pq.Array(organizationIDs), rules.MinAge, pq.Array(rules.Roles), rules.RegisteredBefore)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengevaluasi aturan hak pilih")
+		return
+	}
+	return
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+// GetEligibilityRules - Aturan hak pilih election (admin organization), nil jika belum diatur
+func (u *VoterRollUsecase) GetEligibilityRules(ctx fiber.Ctx, electionID int) (res *entity.EligibilityRules, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.loadEligibilityRules(ctx, electionID)
+	return
+}
+
+// UpdateEligibilityRules - Simpan aturan hak pilih, hanya sebelum election dibuka.
+// DPT tidak langsung berubah, perubahan diterapkan lewat EvaluateEligibilityRules
+func (u *VoterRollUsecase) UpdateEligibilityRules(ctx fiber.Ctx, electionID int, req dto.EligibilityRulesRequest) (res entity.EligibilityRules, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureEditableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = entity.EligibilityRules{
+		MinAge:                    req.MinAge,
+		Roles:                     normalizeRoles(req.Roles),
+		OrganizationIDs:           uniqueIDs(req.OrganizationIDs),
+		IncludeChildOrganizations: req.IncludeChildOrganizations,
+		RegisteredBefore:          req.RegisteredBefore,
+	}
+
+	// organization yang dipilih harus berada di bawah organization penyelenggara
+	if len(res.OrganizationIDs) > 0 {
+		tree, errTree := u.organizationUse.GetOrganizationTree(ctx, election.OrganizationID)
+		if errTree != nil {
+			sysError = errTree
+			return
+		}
+		for _, organizationID := range res.OrganizationIDs {
+			if !slices.Contains(tree, organizationID) {
+				sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest,
+					fmt.Sprintf("Organization %d bukan organization penyelenggara atau turunannya", organizationID))
+				return
+			}
+		}
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan aturan hak pilih")
+		return
+	}
+
+	sysError = u.voterRollRepo.UpdateEligibilityRules(ctx, electionID, string(payload))
+	return
+}
+
+// EvaluateEligibilityRules - Evaluasi aturan hak pilih yang tersimpan dan bandingkan dengan DPT.
+// Anggota yang memenuhi aturan dan belum ada di DPT ditambahkan, entri hasil aturan sebelumnya yang
+// tidak lagi memenuhi aturan dihapus. Dengan dry_run hanya perbedaannya yang dikembalikan
+func (u *VoterRollUsecase) EvaluateEligibilityRules(ctx fiber.Ctx, electionID int, req dto.EvaluateRulesRequest) (res dto.EligibilityDiff, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureEditableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	rules, sysError := u.loadEligibilityRules(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if rules == nil {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Aturan hak pilih election belum diatur")
+		return
+	}
+
+	organizationIDs, sysError := u.resolveRuleOrganizations(ctx, election, *rules)
+	if sysError != nil {
+		return
+	}
+
+	members, sysError := u.voterRollRepo.GetEligibleMembers(ctx, organizationIDs, *rules)
+	if sysError != nil {
+		return
+	}
+
+	voters, sysError := u.voterRollRepo.GetVoterRollsByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = diffEligibility(members, voters)
+	res.DryRun = req.DryRun
+	if req.DryRun {
+		return
+	}
+
+	if len(res.Removed) > 0 {
+		ids := make([]int, 0, len(res.Removed))
+		for _, voter := range res.Removed {
+			ids = append(ids, voter.ID)
+		}
+		if _, sysError = u.voterRollRepo.DeleteVoterRolls(ctx, electionID, ids); sysError != nil {
+			return
+		}
+	}
+
+	userIDs := make([]int, 0, len(res.Added))
+	weights := make([]int, 0, len(res.Added))
+	for _, member := range res.Added {
+		userIDs = append(userIDs, member.UserID)
+		weights = append(weights, 1)
+	}
+	_, sysError = u.addVoterRolls(ctx, electionID, userIDs, weights, entity.SourceRules, false)
+	return
+}
+
+// loadEligibilityRules - Baca aturan hak pilih yang tersimpan pada election
+func (u *VoterRollUsecase) loadEligibilityRules(ctx fiber.Ctx, electionID int) (*entity.EligibilityRules, syserror.SysError) {
+	payload, sysError := u.voterRollRepo.GetEligibilityRules(ctx, electionID)
+	if sysError != nil || payload == nil {
+		return nil, sysError
+	}
+
+	var rules entity.EligibilityRules
+	if err := json.Unmarshal([]byte(*payload), &rules); err != nil {
+		return nil, syserror.CreateError(err, fiber.StatusInternalServerError, "Aturan hak pilih election tidak valid")
+	}
+	return &rules, nil
+}
+
+// resolveRuleOrganizations - Organization yang anggotanya dievaluasi: organization pada aturan
+// (atau organization penyelenggara), ditambah seluruh turunannya jika include_child_organizations aktif
+func (u *VoterRollUsecase) resolveRuleOrganizations(ctx fiber.Ctx, election electionEntity.Election, rules entity.EligibilityRules) ([]int, syserror.SysError) {
+	roots := rules.OrganizationIDs
+	if len(roots) == 0 {
+		roots = []int{election.OrganizationID}
+	}
+	if !rules.IncludeChildOrganizations {
+		return roots, nil
+	}
+
+	organizationIDs := make([]int, 0, len(roots))
+	for _, root := range roots {
+		tree, sysError := u.organizationUse.GetOrganizationTree(ctx, root)
+		if sysError != nil {
+			return nil, sysError
+		}
+		organizationIDs = append(organizationIDs, tree...)
+	}
+	return uniqueIDs(organizationIDs), nil
+}
+
+// diffEligibility - Bandingkan anggota yang memenuhi aturan dengan DPT saat ini.
+// Hanya entri bersumber aturan yang dihapus, entri manual yang tidak memenuhi aturan dilaporkan saja
+func diffEligibility(members []entity.Member, voters []entity.VoterRoll) dto.EligibilityDiff {
+	diff := dto.EligibilityDiff{
+		Eligible:    len(members),
+		Added:       []entity.Member{},
+		Removed:     []entity.VoterRoll{},
+		NotEligible: []entity.VoterRoll{},
+	}
+
+	eligible := make(map[int]bool, len(members))
+	for _, member := range members {
+		eligible[member.UserID] = true
+	}
+
+	rolled := make(map[int]bool, len(voters))
+	for _, voter := range voters {
+		if voter.UserID != nil {
+			rolled[*voter.UserID] = true
+		}
+
+		switch {
+		case voter.UserID != nil && eligible[*voter.UserID]:
+			diff.Unchanged++
+		case voter.Source == entity.SourceRules:
+			diff.Removed = append(diff.Removed, voter)
+		default:
+			diff.NotEligible = append(diff.NotEligible, voter)
+		}
+	}
+
+	for _, member := range members {
+		if !rolled[member.UserID] {
+			diff.Added = append(diff.Added, member)
+		}
+	}
+	return diff
+}
+
+// normalizeRoles - Nama role huruf kecil tanpa duplikat
+func normalizeRoles(roles []string) []string {
+	res := make([]string, 0, len(roles))
+	for _, role := range roles {
+		role = strings.ToLower(strings.TrimSpace(role))
+		if role != "" && !slices.Contains(res, role) {
+			res = append(res, role)
+		}
+	}
+	return res
+}
+
+func uniqueIDs(ids []int) []int {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
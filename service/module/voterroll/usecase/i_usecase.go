@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	organizationUsecase "github.com/madmuzz05/be-enyoblos/service/module/organization/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/repository"
+)
+
+type VoterRollUsecase struct {
+	voterRollRepo   repository.IVoterRollRepository
+	electionUse     electionUsecase.IElectionUsecase
+	organizationUse organizationUsecase.IOrganizationUsecase
+	mainDB          *dbpostgres.MainDB
+}
+
+func InitVoterRollUsecase(voterRollRepo repository.IVoterRollRepository, electionUse electionUsecase.IElectionUsecase, organizationUse organizationUsecase.IOrganizationUsecase, mainDB *dbpostgres.MainDB) IVoterRollUsecase {
+	return &VoterRollUsecase{
+		voterRollRepo:   voterRollRepo,
+		electionUse:     electionUse,
+		organizationUse: organizationUse,
+		mainDB:          mainDB,
+	}
+}
+
+type IVoterRollUsecase interface {
+	GetVoterRolls(ctx fiber.Ctx, electionID int, search string) (res []entity.VoterRoll, totalRecords int64, sysError syserror.SysError)
+	GetVoterRollByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollByID(ctx fiber.Ctx, electionID int, id int) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollByIdentity(ctx fiber.Ctx, electionID int, identity string) (res entity.VoterRoll, sysError syserror.SysError)
+	GetVoterRollsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.VoterRoll, sysError syserror.SysError)
+	ImportMembers(ctx fiber.Ctx, electionID int, req dto.ImportMembersRequest) (res dto.ImportReport, sysError syserror.SysError)
+	ImportCSV(ctx fiber.Ctx, electionID int, file io.Reader, dryRun bool, includeUnregistered bool) (res dto.ImportReport, sysError syserror.SysError)
+	UpdateVoterRollWeight(ctx fiber.Ctx, electionID int, id int, req dto.UpdateWeightRequest) (res entity.VoterRoll, sysError syserror.SysError)
+	DeleteVoterRoll(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError)
+
+	GetEligibilityRules(ctx fiber.Ctx, electionID int) (res *entity.EligibilityRules, sysError syserror.SysError)
+	UpdateEligibilityRules(ctx fiber.Ctx, electionID int, req dto.EligibilityRulesRequest) (res entity.EligibilityRules, sysError syserror.SysError)
+	EvaluateEligibilityRules(ctx fiber.Ctx, electionID int, req dto.EvaluateRulesRequest) (res dto.EligibilityDiff, sysError syserror.SysError)
+}
@@ -0,0 +1,483 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/entity"
+)
+
+// GetVoterRolls - DPT election (admin organization)
+func (u *VoterRollUsecase) GetVoterRolls(ctx fiber.Ctx, electionID int, search string) (res []entity.VoterRoll, totalRecords int64, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, totalRecords, sysError = u.voterRollRepo.GetVoterRolls(ctx, electionID, strings.TrimSpace(search))
+	return
+}
+
+// GetVoterRollByUserID - Entri DPT milik user, dipakai untuk cek hak pilih saat memberikan suara
+func (u *VoterRollUsecase) GetVoterRollByUserID(ctx fiber.Ctx, electionID int, userID int) (res entity.VoterRoll, sysError syserror.SysError) {
+	res, sysError = u.voterRollRepo.GetVoterRollByUserID(ctx, electionID, userID)
+	return
+}
+
+// ImportMembers - Isi DPT dari anggota organization penyelenggara, opsional difilter umur dan domain email
+func (u *VoterRollUsecase) ImportMembers(ctx fiber.Ctx, electionID int, req dto.ImportMembersRequest) (res dto.ImportReport, sysError syserror.SysError) {
+	if req.MinAge > 0 && req.MaxAge > 0 && req.MinAge > req.MaxAge {
+		sysError = syserror.CreateError(fiber.ErrBadRequest, fiber.StatusBadRequest, "min_age tidak boleh lebih besar dari max_age")
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureEditableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	filter := entity.MemberFilter{
+		MinAge:      req.MinAge,
+		MaxAge:      req.MaxAge,
+		EmailDomain: strings.TrimPrefix(strings.ToLower(strings.TrimSpace(req.EmailDomain)), "@"),
+	}
+	members, sysError := u.voterRollRepo.GetMembers(ctx, election.OrganizationID, filter)
+	if sysError != nil {
+		return
+	}
+
+	rolled, sysError := u.getRolledUserIDs(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	res = dto.ImportReport{
+		DryRun:     req.DryRun,
+		TotalRows:  len(members),
+		Matched:    len(members),
+		Unmatched:  []dto.ImportRowIssue{},
+		Duplicates: []dto.ImportRowIssue{},
+	}
+
+	userIDs := make([]int, 0, len(members))
+	weights := make([]int, 0, len(members))
+	for _, member := range members {
+		if rolled[member.UserID] {
+			res.AlreadyOnRoll++
+			continue
+		}
+		userIDs = append(userIDs, member.UserID)
+		weights = append(weights, 1)
+	}
+
+	source := entity.SourceMember
+	if !filter.IsEmpty() {
+		source = entity.SourceFilter
+	}
+	res.Added, sysError = u.addVoterRolls(ctx, electionID, userIDs, weights, source, req.DryRun)
+	return
+}
+
+// ImportCSV - Isi DPT dari file CSV berisi email atau nomor anggota, opsional dengan bobot suara.
+// Baris yang tidak cocok dengan anggota organization dan baris duplikat dilaporkan, tidak ikut dimasukkan,
+// kecuali includeUnregistered aktif sehingga baris tersebut dimasukkan sebagai pemilih tanpa akun.
+// Dengan dry_run hanya laporan yang dikembalikan tanpa mengubah DPT
+func (u *VoterRollUsecase) ImportCSV(ctx fiber.Ctx, electionID int, file io.Reader, dryRun bool, includeUnregistered bool) (res dto.ImportReport, sysError syserror.SysError) {
+	rows, err := parseVoterCSV(file)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusBadRequest, "File CSV tidak valid")
+		return
+	}
+
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.ensureEditableElection(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	members, sysError := u.voterRollRepo.GetMembers(ctx, election.OrganizationID, entity.MemberFilter{})
+	if sysError != nil {
+		return
+	}
+	byEmail := make(map[string]int, len(members))
+	byMemberNumber := make(map[string]int, len(members))
+	for _, member := range members {
+		byEmail[strings.ToLower(member.Email)] = member.UserID
+		if member.MemberNumber != nil && *member.MemberNumber != "" {
+			byMemberNumber[*member.MemberNumber] = member.UserID
+		}
+	}
+
+	voters, sysError := u.voterRollRepo.GetVoterRollsByElectionID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	rolled := make(map[int]bool, len(voters))
+	rolledIdentities := make(map[string]bool, len(voters))
+	for _, voter := range voters {
+		if voter.UserID != nil {
+			rolled[*voter.UserID] = true
+		}
+		for _, key := range identityKeys(voter.Email, voter.MemberNumber) {
+			rolledIdentities[key] = true
+		}
+	}
+
+	res = dto.ImportReport{
+		DryRun:     dryRun,
+		TotalRows:  len(rows),
+		Unmatched:  []dto.ImportRowIssue{},
+		Duplicates: []dto.ImportRowIssue{},
+	}
+
+	seen := make(map[int]int, len(rows))
+	seenIdentities := make(map[string]int, len(rows))
+	userIDs := make([]int, 0, len(rows))
+	weights := make([]int, 0, len(rows))
+	unregistered := make([]entity.VoterRoll, 0)
+	for _, row := range rows {
+		if row.weight <= 0 {
+			res.Unmatched = append(res.Unmatched, dto.ImportRowIssue{Row: row.number, Value: row.value(), Reason: "Bobot suara harus bilangan bulat lebih dari 0"})
+			continue
+		}
+
+		userID, ok := byMemberNumber[row.memberNumber]
+		if !ok {
+			userID, ok = byEmail[row.email]
+		}
+		if !ok {
+			if !includeUnregistered {
+				res.Unmatched = append(res.Unmatched, dto.ImportRowIssue{Row: row.number, Value: row.value(), Reason: "Tidak ditemukan anggota organization dengan email/nomor anggota ini"})
+				continue
+			}
+
+			keys := identityKeys(row.email, &row.memberNumber)
+			if len(keys) == 0 {
+				res.Unmatched = append(res.Unmatched, dto.ImportRowIssue{Row: row.number, Value: row.name, Reason: "Email atau nomor anggota wajib diisi"})
+				continue
+			}
+			if firstRow, duplicate := firstSeen(seenIdentities, keys); duplicate {
+				res.Duplicates = append(res.Duplicates, dto.ImportRowIssue{Row: row.number, Value: row.value(), Reason: fmt.Sprintf("Pemilih yang sama sudah ada di baris %d", firstRow)})
+				continue
+			}
+			for _, key := range keys {
+				seenIdentities[key] = row.number
+			}
+
+			if anyRolled(rolledIdentities, keys) {
+				res.AlreadyOnRoll++
+				continue
+			}
+			unregistered = append(unregistered, row.voterRoll())
+			continue
+		}
+		if firstRow, duplicate := seen[userID]; duplicate {
+			res.Duplicates = append(res.Duplicates, dto.ImportRowIssue{Row: row.number, Value: row.value(), Reason: fmt.Sprintf("Anggota yang sama sudah ada di baris %d", firstRow)})
+			continue
+		}
+		seen[userID] = row.number
+		res.Matched++
+
+		if rolled[userID] {
+			res.AlreadyOnRoll++
+			continue
+		}
+		userIDs = append(userIDs, userID)
+		weights = append(weights, row.weight)
+	}
+
+	res.Added, sysError = u.addVoterRolls(ctx, electionID, userIDs, weights, entity.SourceCSV, dryRun)
+	if sysError != nil {
+		return
+	}
+
+	res.Unregistered = len(unregistered)
+	if !dryRun && len(unregistered) > 0 {
+		added, errAdd := u.voterRollRepo.CreateUnregisteredVoterRolls(ctx, electionID, unregistered, entity.SourceCSV)
+		if errAdd != nil {
+			sysError = errAdd
+			return
+		}
+		res.Unregistered = int(added)
+	}
+	res.Added += res.Unregistered
+	return
+}
+
+// UpdateVoterRollWeight - Ubah bobot suara pemilih DPT, hanya sebelum election dibuka
+func (u *VoterRollUsecase) UpdateVoterRollWeight(ctx fiber.Ctx, electionID int, id int, req dto.UpdateWeightRequest) (res entity.VoterRoll, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureEditableElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, sysError = u.voterRollRepo.UpdateVoterRollWeight(ctx, electionID, id, req.Weight)
+	return
+}
+
+// DeleteVoterRoll - Hapus pemilih dari DPT, hanya sebelum election dibuka
+func (u *VoterRollUsecase) DeleteVoterRoll(ctx fiber.Ctx, electionID int, id int) (sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	if _, sysError = u.ensureEditableElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	sysError = u.voterRollRepo.DeleteVoterRoll(ctx, electionID, id)
+	return
+}
+
+// getRolledUserIDs - Himpunan user yang sudah ada di DPT
+func (u *VoterRollUsecase) getRolledUserIDs(ctx fiber.Ctx, electionID int) (map[int]bool, syserror.SysError) {
+	userIDs, sysError := u.voterRollRepo.GetRolledUserIDs(ctx, electionID)
+	if sysError != nil {
+		return nil, sysError
+	}
+
+	rolled := make(map[int]bool, len(userIDs))
+	for _, userID := range userIDs {
+		rolled[userID] = true
+	}
+	return rolled, nil
+}
+
+// addVoterRolls - Simpan entri DPT baru kecuali dry run, weights sejajar dengan userIDs
+func (u *VoterRollUsecase) addVoterRolls(ctx fiber.Ctx, electionID int, userIDs []int, weights []int, source string, dryRun bool) (int, syserror.SysError) {
+	if dryRun || len(userIDs) == 0 {
+		return len(userIDs), nil
+	}
+
+	added, sysError := u.voterRollRepo.CreateVoterRolls(ctx, electionID, userIDs, weights, source)
+	return int(added), sysError
+}
+
+// ensureEditableElection - Kunci election, pastikan user admin organization dan DPT belum dibekukan.
+// DPT hanya dapat diubah sebelum election dibuka
+func (u *VoterRollUsecase) ensureEditableElection(ctx fiber.Ctx, electionID int) (election electionEntity.Election, sysError syserror.SysError) {
+	election, sysError = u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+
+	if election.Status != electionEntity.StatusDraft && election.Status != electionEntity.StatusScheduled {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "DPT hanya dapat diubah sebelum election dibuka")
+	}
+	return
+}
+
+// GetVoterRollByID - Entri DPT berdasarkan id, dipakai untuk pemilih yang memilih dengan kode voting
+func (u *VoterRollUsecase) GetVoterRollByID(ctx fiber.Ctx, electionID int, id int) (res entity.VoterRoll, sysError syserror.SysError) {
+	res, sysError = u.voterRollRepo.GetVoterRollByID(ctx, electionID, id)
+	return
+}
+
+// GetVoterRollByIdentity - Entri DPT berdasarkan email atau nomor anggota, dipakai untuk mencari penerima kuasa
+func (u *VoterRollUsecase) GetVoterRollByIdentity(ctx fiber.Ctx, electionID int, identity string) (res entity.VoterRoll, sysError syserror.SysError) {
+	res, sysError = u.voterRollRepo.GetVoterRollByIdentity(ctx, electionID, strings.TrimSpace(identity))
+	return
+}
+
+// GetVoterRollsByElectionID - Seluruh entri DPT election tanpa pagination
+func (u *VoterRollUsecase) GetVoterRollsByElectionID(ctx fiber.Ctx, electionID int) (res []entity.VoterRoll, sysError syserror.SysError) {
+	res, sysError = u.voterRollRepo.GetVoterRollsByElectionID(ctx, electionID)
+	return
+}
+
+// identityKeys - Kunci identitas pemilih tanpa akun, sama dengan unique index email dan nomor anggota per election
+func identityKeys(email string, memberNumber *string) (keys []string) {
+	if email != "" {
+		keys = append(keys, "email:"+strings.ToLower(email))
+	}
+	if memberNumber != nil && *memberNumber != "" {
+		keys = append(keys, "member_number:"+*memberNumber)
+	}
+	return keys
+}
+
+func firstSeen(seen map[string]int, keys []string) (int, bool) {
+	for _, key := range keys {
+		if row, ok := seen[key]; ok {
+			return row, true
+		}
+	}
+	return 0, false
+}
+
+func anyRolled(rolled map[string]bool, keys []string) bool {
+	for _, key := range keys {
+		if rolled[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// voterCSVRow - Satu baris CSV DPT, number adalah nomor baris pada file.
+// weight 0 menandakan kolom bobot tidak valid
+type voterCSVRow struct {
+	number       int
+	name         string
+	email        string
+	memberNumber string
+	weight       int
+}
+
+// voterRoll - Entri DPT tanpa akun dari baris CSV, nama diisi identitasnya jika kolom nama kosong
+func (r voterCSVRow) voterRoll() entity.VoterRoll {
+	voter := entity.VoterRoll{
+		Name:   r.name,
+		Email:  r.email,
+		Weight: r.weight,
+	}
+	if voter.Name == "" {
+		voter.Name = r.value()
+	}
+	if r.memberNumber != "" {
+		memberNumber := r.memberNumber
+		voter.MemberNumber = &memberNumber
+	}
+	return voter
+}
+
+func (r voterCSVRow) value() string {
+	if r.memberNumber != "" {
+		return r.memberNumber
+	}
+	return r.email
+}
+
+// parseVoterCSV - Baca CSV DPT. Jika baris pertama berisi header "email" dan/atau "member_number"
+// kolom diambil sesuai header (kolom "name" dan "weight" opsional, bobot kosong berarti 1),
+// jika tidak kolom pertama dianggap email (mengandung @) atau nomor anggota
+func parseVoterCSV(file io.Reader) (rows []voterCSVRow, err error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file CSV kosong")
+	}
+
+	emailColumn, memberNumberColumn, nameColumn, weightColumn := -1, -1, -1, -1
+	for i, header := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(header)) {
+		case "email":
+			emailColumn = i
+		case "member_number", "nomor_anggota":
+			memberNumberColumn = i
+		case "name", "nama":
+			nameColumn = i
+		case "weight", "bobot":
+			weightColumn = i
+		}
+	}
+	hasHeader := emailColumn >= 0 || memberNumberColumn >= 0
+
+	for i, record := range records {
+		if hasHeader && i == 0 {
+			continue
+		}
+
+		row := voterCSVRow{number: i + 1, weight: 1}
+		if hasHeader {
+			row.email = strings.ToLower(column(record, emailColumn))
+			row.memberNumber = column(record, memberNumberColumn)
+			row.name = column(record, nameColumn)
+			row.weight = parseWeight(column(record, weightColumn))
+		} else if value := column(record, 0); strings.Contains(value, "@") {
+			row.email = strings.ToLower(value)
+		} else {
+			row.memberNumber = value
+		}
+
+		// lewati baris kosong
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseWeight - Bobot suara dari kolom CSV, kosong berarti 1 dan 0 jika tidak valid
+func parseWeight(value string) int {
+	if value == "" {
+		return 1
+	}
+	weight, err := strconv.Atoi(value)
+	if err != nil || weight <= 0 {
+		return 0
+	}
+	return weight
+}
+
+func column(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "github.com/madmuzz05/be-enyoblos/package/helper"
+
+// GenerateVotingCodesRequest - DTO untuk membuat kode voting, voter_roll_ids kosong berarti seluruh DPT
+// yang belum memberikan suara. Entri yang sudah punya kode dilewati kecuali regenerate
+type GenerateVotingCodesRequest struct {
+	VoterRollIDs []int `json:"voter_roll_ids" validate:"omitempty,dive,gt=0"`
+	Regenerate   bool  `json:"regenerate"`
+}
+
+// GeneratedVotingCode - Kode voting yang baru dibuat, hanya dikembalikan sekali untuk dicetak
+type GeneratedVotingCode struct {
+	VoterRollID  int     `json:"voter_roll_id"`
+	Name         string  `json:"name"`
+	Email        string  `json:"email"`
+	MemberNumber *string `json:"member_number"`
+	Code         string  `json:"code"`
+}
+
+// VotingCodeStatus - Status kode voting per entri DPT tanpa kode aslinya dan tanpa waktu pemakaiannya
+type VotingCodeStatus struct {
+	VoterRollID  int                `db:"voter_roll_id" json:"voter_roll_id"`
+	Name         string             `db:"name" json:"name"`
+	Email        string             `db:"email" json:"email"`
+	MemberNumber *string            `db:"member_number" json:"member_number"`
+	Status       string             `db:"status" json:"status"`
+	CreatedAt    *helper.CustomTime `db:"created_at" json:"created_at"`
+	RevokedAt    *helper.CustomTime `db:"revoked_at" json:"revoked_at"`
+}
+
+// ExchangeVotingCodeRequest - DTO untuk menukar kode voting dengan ballot token
+type ExchangeVotingCodeRequest struct {
+	Code string `json:"code" validate:"required,max=32"`
+}
+
+// BallotTokenResponse - Ballot token berumur pendek untuk satu kali memberikan suara
+type BallotTokenResponse struct {
+	ElectionID  int               `json:"election_id"`
+	VoterRollID int               `json:"voter_roll_id"`
+	BallotToken string            `json:"ballot_token"`
+	ExpiresAt   helper.CustomTime `json:"expires_at"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+)
+
+const (
+	StatusNone    = "none"
+	StatusActive  = "active"
+	StatusUsed    = "used"
+	StatusRevoked = "revoked"
+)
+
+// VotingCode - Kode voting sekali pakai milik satu entri DPT. Kode asli tidak disimpan, hanya hash-nya
+type VotingCode struct {
+	ID          int                `db:"id" json:"id"`
+	ElectionID  int                `db:"election_id" json:"election_id"`
+	VoterRollID int                `db:"voter_roll_id" json:"voter_roll_id"`
+	CodeHash    string             `db:"code_hash" json:"-"`
+	CreatedAt   helper.CustomTime  `db:"created_at" json:"created_at"`
+	Used        bool               `db:"used" json:"used"`
+	RevokedAt   *helper.CustomTime `db:"revoked_at" json:"revoked_at"`
+}
+
+func (VotingCode) TableName() string {
+	return "voting_codes"
+}
+
+// CodeTarget - Entri DPT yang belum memberikan suara, calon penerima kode voting
+type CodeTarget struct {
+	VoterRollID  int     `db:"voter_roll_id"`
+	Name         string  `db:"name"`
+	Email        string  `db:"email"`
+	MemberNumber *string `db:"member_number"`
+	HasCode      bool    `db:"has_code"`
+}
+
+// NormalizeCode - Samakan penulisan kode: huruf besar tanpa tanda hubung dan spasi
+func NormalizeCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// HashCode - Hash sha256 (hex) dari kode yang sudah dinormalisasi
+func HashCode(code string) string {
+	sum := sha256.Sum256([]byte(NormalizeCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// FormatCode - Kelompokkan kode per 4 karakter supaya mudah dicetak dan diketik, mis. ABCD-EFGH-JKMN
+func FormatCode(code string) string {
+	var groups []string
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), "-")
+}
@@ -0,0 +1,13 @@
+package handler
+
+import "github.com/madmuzz05/be-enyoblos/service/module/votingcode/usecase"
+
+type VotingCodeHandler struct {
+	VotingCodeUsecase usecase.IVotingCodeUsecase
+}
+
+func InitVotingCodeHandler(votingCodeUsecase usecase.IVotingCodeUsecase) *VotingCodeHandler {
+	return &VotingCodeHandler{
+		VotingCodeUsecase: votingCodeUsecase,
+	}
+}
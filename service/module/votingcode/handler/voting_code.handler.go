@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/dto"
+)
+
+// GetVotingCodes - Status kode voting per entri DPT
+// @GET /elections/:id/voting-codes
+func (h *VotingCodeHandler) GetVotingCodes(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	res, totalRecords, sysErr := h.VotingCodeUsecase.GetVotingCodes(ctx, electionID)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendPaginatedResponse(ctx, fiber.StatusOK, "Voting codes retrieved successfully",
+		pagination.Page, pagination.PageSize, totalRecords, res)
+}
+
+// GenerateVotingCodes - Buat kode voting dan unduh sebagai CSV untuk dicetak
+// @POST /elections/:id/voting-codes
+// Body: {voter_roll_ids?: []int, regenerate?: bool}
+func (h *VotingCodeHandler) GenerateVotingCodes(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.GenerateVotingCodesRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VotingCodeUsecase.GenerateVotingCodes(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write([]string{"voter_roll_id", "name", "email", "member_number", "code"})
+	for _, code := range res {
+		memberNumber := ""
+		if code.MemberNumber != nil {
+			memberNumber = *code.MemberNumber
+		}
+		_ = writer.Write([]string{strconv.Itoa(code.VoterRollID), code.Name, code.Email, memberNumber, code.Code})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusInternalServerError, "Gagal menyusun file CSV", err)
+	}
+
+	ctx.Attachment(fmt.Sprintf("voting-codes-election-%d.csv", electionID))
+	return ctx.Status(fiber.StatusCreated).Send(buf.Bytes())
+}
+
+// ExchangeVotingCode - Tukar kode voting dengan ballot token
+// @POST /elections/:id/voting-codes/exchange
+// Body: {code: string}
+func (h *VotingCodeHandler) ExchangeVotingCode(ctx fiber.Ctx) error {
+	electionID, err := strconv.Atoi(ctx.Params("id"))
+	if err != nil {
+		return helper.SendErrorResponse(ctx, fiber.StatusBadRequest, "Invalid election ID", err)
+	}
+
+	var req dto.ExchangeVotingCodeRequest
+	if validationErrors, err := helper.ValidateRequest(ctx, &req); err != nil {
+		return helper.SendResponse(ctx, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	res, sysErr := h.VotingCodeUsecase.ExchangeVotingCode(ctx, electionID, req)
+	if sysErr != nil {
+		return helper.SendErrorResponse(ctx, sysErr.GetStatusCode(), sysErr.GetMessage(), sysErr.GetError())
+	}
+
+	return helper.SendResponse(ctx, fiber.StatusOK, "Voting code exchanged successfully", res)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/entity"
+)
+
+type VotingCodeRepository struct {
+	mainDB *database.MainDB
+}
+
+func InitVotingCodeRepository(mainDB *database.MainDB) IVotingCodeRepository {
+	return &VotingCodeRepository{
+		mainDB: mainDB,
+	}
+}
+
+func (r *VotingCodeRepository) GetMainDB(ctx fiber.Ctx) (tx interface{}) {
+	return r.mainDB.DB
+}
+
+type IVotingCodeRepository interface {
+	GetMainDB(ctx fiber.Ctx) (tx interface{})
+
+	GetVotingCodeStatuses(ctx fiber.Ctx, electionID int) (res []dto.VotingCodeStatus, totalRecords int64, sysError syserror.SysError)
+	GetCodeTargets(ctx fiber.Ctx, electionID int, voterRollIDs []int) (res []entity.CodeTarget, sysError syserror.SysError)
+	UpsertVotingCodes(ctx fiber.Ctx, electionID int, codes []entity.VotingCode) (sysError syserror.SysError)
+	GetVotingCodeByHash(ctx fiber.Ctx, electionID int, codeHash string) (res entity.VotingCode, sysError syserror.SysError)
+	GetVotingCodeByVoterRollIDForUpdate(ctx fiber.Ctx, electionID int, voterRollID int) (res entity.VotingCode, sysError syserror.SysError)
+	MarkVotingCodeUsed(ctx fiber.Ctx, id int) (sysError syserror.SysError)
+}
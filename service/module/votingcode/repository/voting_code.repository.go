@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/entity"
+)
+
+const votingCodeColumns = `id, election_id, voter_roll_id, code_hash, created_at, used, revoked_at`
+
+// GetVotingCodeStatuses - Status kode voting setiap entri DPT election
+func (r *VotingCodeRepository) GetVotingCodeStatuses(ctx fiber.Ctx, electionID int) (res []dto.VotingCodeStatus, totalRecords int64, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	// Parse pagination
+	pagination := helper.ParsePaginationFromQuery(ctx)
+	offset := helper.GetOffset(pagination.Page, pagination.PageSize)
+
+	model := db.Get(&totalRecords, `SELECT COUNT(*) FROM public.voter_rolls WHERE election_id = $1`, electionID)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil total records")
+		return
+	}
+
+	query := `SELECT v.id AS voter_roll_id, v.name, v.email, v.member_number,
+	                 CASE WHEN c.id IS NULL THEN 'none'
+	                      WHEN c.used THEN 'used'
+	                      WHEN c.revoked_at IS NOT NULL THEN 'revoked'
+	                      ELSE 'active' END AS status,
+	                 c.created_at, c.revoked_at
+	          FROM public.voter_rolls v
+	          LEFT JOIN public.voting_codes c ON c.voter_roll_id = v.id
+	          WHERE v.election_id = $1
+	          ORDER BY v.name, v.id
+	          LIMIT $2 OFFSET $3`
+
+	model = db.Select(&res, query, electionID, pagination.PageSize, offset)
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kode voting")
+		return
+	}
+	return
+}
+
+// GetCodeTargets - Entri DPT yang belum memberikan suara, voterRollIDs kosong berarti seluruh DPT
+func (r *VotingCodeRepository) GetCodeTargets(ctx fiber.Ctx, electionID int, voterRollIDs []int) (res []entity.CodeTarget, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT v.id AS voter_roll_id, v.name, v.email, v.member_number, (c.id IS NOT NULL) AS has_code
+	          FROM public.voter_rolls v
+	          LEFT JOIN public.voting_codes c ON c.voter_roll_id = v.id
+	          LEFT JOIN public.election_participations p ON p.voter_roll_id = v.id AND p.election_id = v.election_id
+	          WHERE v.election_id = $1
+	            AND p.voter_roll_id IS NULL
+	            AND (CARDINALITY($2::int[]) = 0 OR v.id = ANY($2))
+	          ORDER BY v.name, v.id`
+
+	model := db.Select(&res, query, electionID, pq.Array(voterRollIDs))
+	if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil DPT")
+		return
+	}
+	return
+}
+
+// UpsertVotingCodes - Simpan kode voting baru, kode lama milik entri DPT yang sama diganti
+func (r *VotingCodeRepository) UpsertVotingCodes(ctx fiber.Ctx, electionID int, codes []entity.VotingCode) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	voterRollIDs := make([]int, len(codes))
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		voterRollIDs[i] = code.VoterRollID
+		hashes[i] = code.CodeHash
+	}
+
+	query := `INSERT INTO public.voting_codes (election_id, voter_roll_id, code_hash, created_at)
+	          SELECT $1, c.voter_roll_id, c.code_hash, $2
+	          FROM UNNEST($3::int[], $4::text[]) AS c(voter_roll_id, code_hash)
+	          ON CONFLICT (voter_roll_id) DO UPDATE
+	          SET code_hash = EXCLUDED.code_hash, created_at = EXCLUDED.created_at, used = FALSE, revoked_at = NULL`
+
+	_, err := db.Exec(query, electionID, helper.Now(), pq.Array(voterRollIDs), pq.Array(hashes))
+	if database.IsUniqueViolation(err) {
+		sysError = syserror.CreateError(err, fiber.StatusConflict, "Kode voting bentrok, silakan ulangi")
+		return
+	} else if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal menyimpan kode voting")
+	}
+	return
+}
+
+func (r *VotingCodeRepository) GetVotingCodeByHash(ctx fiber.Ctx, electionID int, codeHash string) (res entity.VotingCode, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + votingCodeColumns + ` FROM public.voting_codes WHERE code_hash = $1 AND election_id = $2`
+
+	model := db.Get(&res, query, codeHash, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Kode voting tidak valid")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kode voting")
+	}
+	return
+}
+
+// GetVotingCodeByVoterRollIDForUpdate - Kunci kode voting entri DPT supaya hanya satu suara yang bisa memakainya
+func (r *VotingCodeRepository) GetVotingCodeByVoterRollIDForUpdate(ctx fiber.Ctx, electionID int, voterRollID int) (res entity.VotingCode, sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `SELECT ` + votingCodeColumns + ` FROM public.voting_codes WHERE voter_roll_id = $1 AND election_id = $2 FOR UPDATE`
+
+	model := db.Get(&res, query, voterRollID, electionID)
+	if errors.Is(model, sql.ErrNoRows) {
+		sysError = syserror.CreateError(fiber.ErrNotFound, fiber.StatusNotFound, "Kode voting tidak ditemukan")
+		return
+	} else if model != nil {
+		sysError = syserror.CreateError(model, fiber.StatusInternalServerError, "Gagal mengambil kode voting")
+	}
+	return
+}
+
+// MarkVotingCodeUsed - Tandai kode voting terpakai tanpa waktu pemakaian, supaya pemegang kode tidak bisa
+// diurutkan lalu dicocokkan dengan urutan rantai suara
+func (r *VotingCodeRepository) MarkVotingCodeUsed(ctx fiber.Ctx, id int) (sysError syserror.SysError) {
+	db := database.DBWithCtx{
+		DB:  database.UseTx(ctx, r.mainDB.DB),
+		Ctx: ctx.Context(),
+	}
+
+	query := `UPDATE public.voting_codes SET used = TRUE WHERE id = $1`
+
+	if _, err := db.Exec(query, id); err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal memperbarui kode voting")
+	}
+	return
+}
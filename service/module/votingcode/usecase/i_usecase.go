@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"github.com/gofiber/fiber/v3"
+	dbpostgres "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/repository"
+)
+
+type VotingCodeUsecase struct {
+	votingCodeRepo repository.IVotingCodeRepository
+	electionUse    electionUsecase.IElectionUsecase
+	mainDB         *dbpostgres.MainDB
+}
+
+func InitVotingCodeUsecase(votingCodeRepo repository.IVotingCodeRepository, electionUse electionUsecase.IElectionUsecase, mainDB *dbpostgres.MainDB) IVotingCodeUsecase {
+	return &VotingCodeUsecase{
+		votingCodeRepo: votingCodeRepo,
+		electionUse:    electionUse,
+		mainDB:         mainDB,
+	}
+}
+
+type IVotingCodeUsecase interface {
+	GetVotingCodes(ctx fiber.Ctx, electionID int) (res []dto.VotingCodeStatus, totalRecords int64, sysError syserror.SysError)
+	GenerateVotingCodes(ctx fiber.Ctx, electionID int, req dto.GenerateVotingCodesRequest) (res []dto.GeneratedVotingCode, sysError syserror.SysError)
+	ExchangeVotingCode(ctx fiber.Ctx, electionID int, req dto.ExchangeVotingCodeRequest) (res dto.BallotTokenResponse, sysError syserror.SysError)
+	RedeemVotingCode(ctx fiber.Ctx, electionID int, voterRollID int, codeHash string) (sysError syserror.SysError)
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
+	syserror "github.com/madmuzz05/be-enyoblos/package/error"
+	"github.com/madmuzz05/be-enyoblos/package/helper"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	electionEntity "github.com/madmuzz05/be-enyoblos/service/module/election/entity"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/dto"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/entity"
+)
+
+// votingCodeLength - panjang kode voting tanpa tanda hubung
+const votingCodeLength = 12
+
+// GetVotingCodes - Status kode voting per entri DPT (admin organization), kode asli tidak pernah ditampilkan lagi
+func (u *VotingCodeUsecase) GetVotingCodes(ctx fiber.Ctx, electionID int) (res []dto.VotingCodeStatus, totalRecords int64, sysError syserror.SysError) {
+	if _, sysError = u.electionUse.GetManagedElection(ctx, electionID); sysError != nil {
+		return
+	}
+
+	res, totalRecords, sysError = u.votingCodeRepo.GetVotingCodeStatuses(ctx, electionID)
+	return
+}
+
+// GenerateVotingCodes - Buat kode voting sekali pakai untuk entri DPT yang belum memberikan suara.
+// Kode asli hanya dikembalikan di response ini untuk dicetak, database hanya menyimpan hash-nya
+func (u *VotingCodeUsecase) GenerateVotingCodes(ctx fiber.Ctx, electionID int, req dto.GenerateVotingCodesRequest) (res []dto.GeneratedVotingCode, sysError syserror.SysError) {
+	tx, errTx := database.TxCreate(ctx, u.mainDB.DB)
+	if errTx != nil {
+		sysError = errTx
+		return
+	}
+	defer func() {
+		sysError = database.TxSubmitTerr(ctx, sysError)
+		// kode yang gagal tersimpan tidak boleh sampai dicetak
+		if sysError != nil {
+			res = nil
+		}
+	}()
+
+	if tx == nil {
+		sysError = syserror.CreateError(fmt.Errorf("failed to begin transaction"), fiber.StatusInternalServerError, "Gagal memulai transaksi")
+		return
+	}
+
+	election, sysError := u.electionUse.GetManagedElectionForUpdate(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	switch election.Status {
+	case electionEntity.StatusDraft, electionEntity.StatusScheduled, electionEntity.StatusOpen:
+	default:
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kode voting tidak dapat dibuat setelah election ditutup")
+		return
+	}
+
+	targets, sysError := u.votingCodeRepo.GetCodeTargets(ctx, electionID, req.VoterRollIDs)
+	if sysError != nil {
+		return
+	}
+
+	res = []dto.GeneratedVotingCode{}
+	codes := make([]entity.VotingCode, 0, len(targets))
+	for _, target := range targets {
+		if target.HasCode && !req.Regenerate {
+			continue
+		}
+
+		code, err := helper.RandomCode(votingCodeLength)
+		if err != nil {
+			sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat kode voting")
+			return
+		}
+
+		codes = append(codes, entity.VotingCode{
+			ElectionID:  electionID,
+			VoterRollID: target.VoterRollID,
+			CodeHash:    entity.HashCode(code),
+		})
+		res = append(res, dto.GeneratedVotingCode{
+			VoterRollID:  target.VoterRollID,
+			Name:         target.Name,
+			Email:        target.Email,
+			MemberNumber: target.MemberNumber,
+			Code:         entity.FormatCode(code),
+		})
+	}
+
+	if len(codes) == 0 {
+		return
+	}
+	sysError = u.votingCodeRepo.UpsertVotingCodes(ctx, electionID, codes)
+	return
+}
+
+// ExchangeVotingCode - Tukar kode voting dengan ballot token berumur pendek, tanpa akun user.
+// Kode baru ditandai terpakai saat suara disimpan sehingga token yang kedaluwarsa bisa ditukar ulang
+func (u *VotingCodeUsecase) ExchangeVotingCode(ctx fiber.Ctx, electionID int, req dto.ExchangeVotingCodeRequest) (res dto.BallotTokenResponse, sysError syserror.SysError) {
+	election, sysError := u.electionUse.GetElectionByID(ctx, electionID)
+	if sysError != nil {
+		return
+	}
+	if election.Status != electionEntity.StatusOpen {
+		sysError = syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Election tidak sedang dibuka untuk pemungutan suara")
+		return
+	}
+
+	code, sysError := u.votingCodeRepo.GetVotingCodeByHash(ctx, electionID, entity.HashCode(req.Code))
+	if sysError != nil {
+		return
+	}
+	if sysError = checkUsable(code); sysError != nil {
+		return
+	}
+
+	token, err := middleware.GenerateBallotToken(electionID, code.VoterRollID, code.CodeHash)
+	if err != nil {
+		sysError = syserror.CreateError(err, fiber.StatusInternalServerError, "Gagal membuat ballot token")
+		return
+	}
+
+	res = dto.BallotTokenResponse{
+		ElectionID:  electionID,
+		VoterRollID: code.VoterRollID,
+		BallotToken: token.AccessToken,
+		ExpiresAt:   token.ExpiresIn,
+	}
+	return
+}
+
+// RedeemVotingCode - Tandai kode voting terpakai untuk suara yang sedang disimpan.
+// Harus dipanggil di dalam transaction pemungutan suara, kode dikunci supaya hanya satu suara yang lolos
+func (u *VotingCodeUsecase) RedeemVotingCode(ctx fiber.Ctx, electionID int, voterRollID int, codeHash string) (sysError syserror.SysError) {
+	code, sysError := u.votingCodeRepo.GetVotingCodeByVoterRollIDForUpdate(ctx, electionID, voterRollID)
+	if sysError != nil {
+		if sysError.GetStatusCode() == fiber.StatusNotFound {
+			sysError = syserror.CreateError(sysError.GetError(), fiber.StatusUnauthorized, "Kode voting sudah tidak berlaku")
+		}
+		return
+	}
+
+	// kode yang dibuat ulang membatalkan ballot token dari kode lama
+	if code.CodeHash != codeHash {
+		sysError = syserror.CreateError(fiber.ErrUnauthorized, fiber.StatusUnauthorized, "Kode voting sudah tidak berlaku")
+		return
+	}
+	if sysError = checkUsable(code); sysError != nil {
+		return
+	}
+
+	sysError = u.votingCodeRepo.MarkVotingCodeUsed(ctx, code.ID)
+	return
+}
+
+func checkUsable(code entity.VotingCode) syserror.SysError {
+	if code.Used {
+		return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kode voting sudah digunakan")
+	}
+	if code.RevokedAt != nil {
+		return syserror.CreateError(fiber.ErrConflict, fiber.StatusConflict, "Kode voting sudah tidak berlaku")
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/ballot/handler"
+)
+
+type ballotRoutes struct {
+	Handler     *handler.BallotHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitBallotRoutes(router fiber.Router, ballotHandler *handler.BallotHandler, redis *redisdb.RedisClient) *ballotRoutes {
+	return &ballotRoutes{
+		Handler:     ballotHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *ballotRoutes) Routes() {
+	router := r.Router
+	ballot := router.Group("/elections/:id/ballots")
+
+	// GET /elections/:id/ballots/receipts/:receipt_code - Verify a receipt is in the ballot chain (public)
+	ballot.Get("/receipts/:receipt_code", r.Handler.VerifyReceipt)
+
+	// GET /elections/:id/ballots/audit - Re-walk the ballot hash chain (public)
+	ballot.Get("/audit", r.Handler.AuditChain)
+
+	// GET /elections/:id/ballots/code/paper - Ballot paper for a ballot token holder
+	ballot.Get("/code/paper", middleware.BallotTokenMiddleware(r.Handler.GetBallotPaperWithCode))
+
+	// POST /elections/:id/ballots/code - Cast a ballot with a ballot token from an exchanged voting code
+	ballot.Post("/code", middleware.BallotTokenMiddleware(r.Handler.CastBallotWithCode))
+
+	// GET /elections/:id/ballots/kiosk/paper - Ballot paper for the voter unlocked on a poll-station kiosk
+	ballot.Get("/kiosk/paper", middleware.KioskTokenMiddleware(r.Handler.GetBallotPaperWithKiosk))
+
+	// POST /elections/:id/ballots/kiosk - Cast one ballot from a poll-station kiosk, consuming the poll worker's unlock
+	ballot.Post("/kiosk", middleware.KioskTokenMiddleware(r.Handler.CastBallotWithKiosk))
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/ballots/paper - Ballot paper with candidates in the election's candidate order (voter on the voter roll)
+	ballot.Get("/paper", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetBallotPaper))
+
+	// POST /elections/:id/ballots - Cast a ballot (voter on the election's voter roll, or a proxy with delegation_id)
+	ballot.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CastBallot))
+
+	// GET /elections/:id/results - Tally results (admin when closed, every user when published)
+	router.Get("/elections/:id/results", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetResults))
+
+	// GET /elections/:id/live - Server-Sent Events stream of turnout, plus tallies after close (admin organization) or publish (observer)
+	// EventSource clients pass a short-lived ?stream_token= from POST /elections/:id/live/token instead of the access token
+	router.Get("/elections/:id/live", middleware.StreamTokenMiddleware(r.RedisClient, r.Handler.StreamLive))
+
+	// POST /elections/:id/live/token - Issue a short-lived stream token for the live stream (admin organization or observer)
+	router.Post("/elections/:id/live/token", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.IssueStreamToken))
+
+	// GET /elections/:id/ballots/turnout - Turnout summary (admin organization or observer)
+	ballot.Get("/turnout", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetTurnout))
+
+	// GET /elections/:id/ballots/participations - Voted / not voted per voter roll entry (admin organization)
+	ballot.Get("/participations", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetVoterParticipations))
+}
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/candidate/handler"
+)
+
+type candidateRoutes struct {
+	Handler     *handler.CandidateHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitCandidateRoutes(router fiber.Router, candidateHandler *handler.CandidateHandler, redis *redisdb.RedisClient) *candidateRoutes {
+	return &candidateRoutes{
+		Handler:     candidateHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *candidateRoutes) Routes() {
+	router := r.Router
+	candidate := router.Group("/elections/:id/candidates")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/candidates - Get all candidates of an election
+	candidate.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetCandidates))
+
+	// GET /elections/:id/candidates/:candidate_id - Get candidate by ID
+	candidate.Get("/:candidate_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetCandidateByID))
+
+	// POST /elections/:id/candidates - Create new candidate (admin organization, draft only)
+	candidate.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateCandidate))
+
+	// POST /elections/:id/candidates/ballot-numbers - Re-number candidates sequentially or by lottery
+	candidate.Post("/ballot-numbers", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.AssignBallotNumbers))
+
+	// PUT /elections/:id/candidates/:candidate_id - Update candidate (admin organization, draft only)
+	candidate.Put("/:candidate_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UpdateCandidate))
+
+	// DELETE /elections/:id/candidates/:candidate_id - Delete candidate (admin organization, draft only)
+	candidate.Delete("/:candidate_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteCandidate))
+}
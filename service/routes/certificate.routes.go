@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/certificate/handler"
+)
+
+type certificateRoutes struct {
+	Handler     *handler.CertificateHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitCertificateRoutes(router fiber.Router, certificateHandler *handler.CertificateHandler, redis *redisdb.RedisClient) *certificateRoutes {
+	return &certificateRoutes{
+		Handler:     certificateHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *certificateRoutes) Routes() {
+	router := r.Router
+	certificate := router.Group("/certificates")
+
+	// GET /certificates/public-key - Ed25519 public key used to sign certificates (public)
+	certificate.Get("/public-key", r.Handler.GetSigningKey)
+
+	// POST /certificates/verify - Verify a certificate document or canonical text signature (public)
+	certificate.Post("/verify", r.Handler.VerifyCertificate)
+
+	// GET /elections/:id/certificate - Signed results certificate of a published election (public)
+	// ?format=text returns the canonical text with the signature in X-Certificate-* headers
+	router.Get("/elections/:id/certificate", r.Handler.GetCertificate)
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// POST /elections/:id/certificate - Issue the signed results certificate once the dispute window of a published election ends (admin organization)
+	router.Post("/elections/:id/certificate", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.IssueCertificate))
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/contest/handler"
+)
+
+type contestRoutes struct {
+	Handler     *handler.ContestHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitContestRoutes(router fiber.Router, contestHandler *handler.ContestHandler, redis *redisdb.RedisClient) *contestRoutes {
+	return &contestRoutes{
+		Handler:     contestHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *contestRoutes) Routes() {
+	router := r.Router
+	contest := router.Group("/elections/:id/contests")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/contests - Get all contests (positions) of an election
+	contest.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetContests))
+
+	// GET /elections/:id/contests/:contest_id - Get contest by ID
+	contest.Get("/:contest_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetContestByID))
+
+	// POST /elections/:id/contests - Create new contest (admin organization, draft only)
+	contest.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateContest))
+
+	// PUT /elections/:id/contests/:contest_id - Update contest (admin organization, draft only)
+	contest.Put("/:contest_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UpdateContest))
+
+	// DELETE /elections/:id/contests/:contest_id - Delete contest and its candidates (admin organization, draft only)
+	contest.Delete("/:contest_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteContest))
+}
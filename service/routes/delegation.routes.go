@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/delegation/handler"
+)
+
+type delegationRoutes struct {
+	Handler     *handler.DelegationHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitDelegationRoutes(router fiber.Router, delegationHandler *handler.DelegationHandler, redis *redisdb.RedisClient) *delegationRoutes {
+	return &delegationRoutes{
+		Handler:     delegationHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *delegationRoutes) Routes() {
+	router := r.Router
+	delegation := router.Group("/elections/:id/delegations")
+
+	// ============ Protected Routes (requires JWT, voter on the roll) ============
+
+	// GET /elections/:id/delegations/me - Delegation given and proxies held by the logged in voter
+	delegation.Get("/me", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetMyDelegations))
+
+	// POST /elections/:id/delegations - Delegate the logged in voter's vote to another voter
+	delegation.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateDelegation))
+
+	// DELETE /elections/:id/delegations/:delegation_id - Revoke an unused delegation (delegator or proxy)
+	delegation.Delete("/:delegation_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RevokeDelegation))
+
+	// ============ Protected Routes (requires JWT, admin organization) ============
+
+	// GET /elections/:id/delegations - All delegations of an election
+	delegation.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetDelegations))
+
+	// GET /elections/:id/delegations/events - Delegation audit trail
+	delegation.Get("/events", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetDelegationEvents))
+}
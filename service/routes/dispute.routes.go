@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/dispute/handler"
+)
+
+type disputeRoutes struct {
+	Handler     *handler.DisputeHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitDisputeRoutes(router fiber.Router, disputeHandler *handler.DisputeHandler, redis *redisdb.RedisClient) *disputeRoutes {
+	return &disputeRoutes{
+		Handler:     disputeHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *disputeRoutes) Routes() {
+	router := r.Router
+	dispute := router.Group("/elections/:id/disputes")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/disputes/me - Own disputes
+	dispute.Get("/me", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetMyDisputes))
+
+	// POST /elections/:id/disputes - File a dispute during the dispute window after publication (voter or candidate)
+	dispute.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.FileDispute))
+
+	// GET /elections/:id/disputes - List disputes, filterable by ?status= (admin organization)
+	dispute.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetDisputes))
+
+	// GET /elections/:id/disputes/:dispute_id - Dispute detail with responses and attachments (filer or admin organization)
+	dispute.Get("/:dispute_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetDisputeByID))
+
+	// POST /elections/:id/disputes/:dispute_id/responses - Respond to an open dispute (filer or admin organization)
+	dispute.Post("/:dispute_id/responses", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RespondDispute))
+
+	// POST /elections/:id/disputes/:dispute_id/resolve - Uphold the dispute with a resolution (admin organization)
+	dispute.Post("/:dispute_id/resolve", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.ResolveDispute))
+
+	// POST /elections/:id/disputes/:dispute_id/dismiss - Dismiss the dispute with a reason (admin organization)
+	dispute.Post("/:dispute_id/dismiss", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DismissDispute))
+
+	// POST /elections/:id/disputes/:dispute_id/attachments - Upload evidence (filer, open disputes only)
+	dispute.Post("/:dispute_id/attachments", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UploadAttachment))
+
+	// GET /elections/:id/disputes/:dispute_id/attachments/:attachment_id - Download evidence (filer or admin organization)
+	dispute.Get("/:dispute_id/attachments/:attachment_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DownloadAttachment))
+}
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/election/handler"
+)
+
+type electionRoutes struct {
+	Handler     *handler.ElectionHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitElectionRoutes(router fiber.Router, electionHandler *handler.ElectionHandler, redis *redisdb.RedisClient) *electionRoutes {
+	return &electionRoutes{
+		Handler:     electionHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *electionRoutes) Routes() {
+	router := r.Router
+	election := router.Group("/elections")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections - Get all elections
+	election.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetElections))
+
+	// GET /elections/:id - Get election by ID
+	election.Get("/:id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetElectionByID))
+
+	// POST /elections - Create new election (admin organization)
+	election.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateElection))
+
+	// PUT /elections/:id - Update election (admin organization, draft only)
+	election.Put("/:id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UpdateElection))
+
+	// DELETE /elections/:id - Delete election (admin organization, draft only)
+	election.Delete("/:id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteElection))
+
+	// PATCH /elections/:id/status - Change election status (admin organization)
+	election.Patch("/:id/status", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.ChangeStatus))
+
+	// GET /elections/:id/status-logs - Election status history (admin organization or observer)
+	election.Get("/:id/status-logs", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetStatusLogs))
+}
@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/kiosk/handler"
+)
+
+type kioskRoutes struct {
+	Handler     *handler.KioskHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitKioskRoutes(router fiber.Router, kioskHandler *handler.KioskHandler, redis *redisdb.RedisClient) *kioskRoutes {
+	return &kioskRoutes{
+		Handler:     kioskHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *kioskRoutes) Routes() {
+	router := r.Router
+	kiosk := router.Group("/elections/:id/kiosks")
+	pollWorker := router.Group("/elections/:id/poll-workers")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/kiosks - List kiosks with their unlock state (admin organization or poll worker)
+	kiosk.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetKiosks))
+
+	// POST /elections/:id/kiosks - Register a kiosk device, returns its device key once (admin organization)
+	kiosk.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RegisterKiosk))
+
+	// DELETE /elections/:id/kiosks/:kiosk_id - Revoke a kiosk and its kiosk token (admin organization)
+	kiosk.Delete("/:kiosk_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RevokeKiosk))
+
+	// POST /elections/:id/kiosks/:kiosk_id/authenticate - Activate a kiosk with its device key and issue a kiosk token (admin organization or poll worker)
+	kiosk.Post("/:kiosk_id/authenticate", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.AuthenticateKiosk))
+
+	// POST /elections/:id/kiosks/:kiosk_id/unlock - Unlock one ballot on the kiosk for a checked-in voter (admin organization or poll worker)
+	kiosk.Post("/:kiosk_id/unlock", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UnlockBallot))
+
+	// DELETE /elections/:id/kiosks/:kiosk_id/unlock - Cancel the ballot unlocked on the kiosk (admin organization or poll worker)
+	kiosk.Delete("/:kiosk_id/unlock", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CancelUnlock))
+
+	// GET /elections/:id/poll-workers - List poll workers (admin organization)
+	pollWorker.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetPollWorkers))
+
+	// POST /elections/:id/poll-workers - Appoint a poll worker (admin organization)
+	pollWorker.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.AddPollWorker))
+
+	// DELETE /elections/:id/poll-workers/:poll_worker_id - Remove a poll worker (admin organization)
+	pollWorker.Delete("/:poll_worker_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeletePollWorker))
+}
@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/nomination/handler"
+)
+
+type nominationRoutes struct {
+	Handler     *handler.NominationHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitNominationRoutes(router fiber.Router, nominationHandler *handler.NominationHandler, redis *redisdb.RedisClient) *nominationRoutes {
+	return &nominationRoutes{
+		Handler:     nominationHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *nominationRoutes) Routes() {
+	router := r.Router
+	nomination := router.Group("/elections/:id/nominations")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/nominations/me - Own nominations, as nominee or nominator
+	nomination.Get("/me", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetMyNominations))
+
+	// POST /elections/:id/nominations - Nominate yourself or another member during the nomination window (organization member)
+	nomination.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateNomination))
+
+	// GET /elections/:id/nominations - List nominations, filterable by ?status= (admin organization)
+	nomination.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetNominations))
+
+	// GET /elections/:id/nominations/:nomination_id - Nomination detail with documents (nominee, nominator or admin organization)
+	nomination.Get("/:nomination_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetNominationByID))
+
+	// POST /elections/:id/nominations/:nomination_id/withdraw - Withdraw a pending nomination (nominee or nominator)
+	nomination.Post("/:nomination_id/withdraw", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.WithdrawNomination))
+
+	// POST /elections/:id/nominations/:nomination_id/approve - Approve and add to the ballot as a candidate (admin organization)
+	nomination.Post("/:nomination_id/approve", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.ApproveNomination))
+
+	// POST /elections/:id/nominations/:nomination_id/reject - Reject with a reason (admin organization)
+	nomination.Post("/:nomination_id/reject", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RejectNomination))
+
+	// POST /elections/:id/nominations/:nomination_id/documents - Upload a supporting document (nominee or nominator, pending only)
+	nomination.Post("/:nomination_id/documents", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UploadDocument))
+
+	// GET /elections/:id/nominations/:nomination_id/documents/:document_id - Download a supporting document (nominee, nominator or admin organization)
+	nomination.Get("/:nomination_id/documents/:document_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DownloadDocument))
+
+	// DELETE /elections/:id/nominations/:nomination_id/documents/:document_id - Delete a supporting document (nominee or nominator, pending only)
+	nomination.Delete("/:nomination_id/documents/:document_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteDocument))
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/notification/handler"
+)
+
+type notificationRoutes struct {
+	Handler     *handler.NotificationHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitNotificationRoutes(router fiber.Router, notificationHandler *handler.NotificationHandler, redis *redisdb.RedisClient) *notificationRoutes {
+	return &notificationRoutes{
+		Handler:     notificationHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *notificationRoutes) Routes() {
+	router := r.Router
+	notification := router.Group("/notifications")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /notifications/me - Own notifications, newest first (?unread=true for unread only)
+	notification.Get("/me", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetMyNotifications))
+
+	// PATCH /notifications/me/read - Mark all own notifications as read
+	notification.Patch("/me/read", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.MarkAllAsRead))
+
+	// PATCH /notifications/:notification_id/read - Mark one own notification as read
+	notification.Patch("/:notification_id/read", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.MarkAsRead))
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/observer/handler"
+)
+
+type observerRoutes struct {
+	Handler     *handler.ObserverHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitObserverRoutes(router fiber.Router, observerHandler *handler.ObserverHandler, redis *redisdb.RedisClient) *observerRoutes {
+	return &observerRoutes{
+		Handler:     observerHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *observerRoutes) Routes() {
+	router := r.Router
+	observer := router.Group("/elections/:id/observers")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/observers - List observers (admin organization)
+	observer.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetObservers))
+
+	// POST /elections/:id/observers - Appoint an observer (admin organization)
+	observer.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.AddObserver))
+
+	// DELETE /elections/:id/observers/:observer_id - Remove an observer (admin organization)
+	observer.Delete("/:observer_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteObserver))
+}
@@ -3,16 +3,65 @@ package routes
 import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
+	"github.com/madmuzz05/be-enyoblos/config"
 	database "github.com/madmuzz05/be-enyoblos/package/database/postgres"
 	"github.com/madmuzz05/be-enyoblos/package/middleware"
 	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/package/signer"
 	authHandler "github.com/madmuzz05/be-enyoblos/service/module/auth/handler"
 	authUsecase "github.com/madmuzz05/be-enyoblos/service/module/auth/usecase"
+	ballotHandler "github.com/madmuzz05/be-enyoblos/service/module/ballot/handler"
+	ballotRepository "github.com/madmuzz05/be-enyoblos/service/module/ballot/repository"
+	ballotUsecase "github.com/madmuzz05/be-enyoblos/service/module/ballot/usecase"
+	candidateHandler "github.com/madmuzz05/be-enyoblos/service/module/candidate/handler"
+	candidateRepository "github.com/madmuzz05/be-enyoblos/service/module/candidate/repository"
+	candidateUsecase "github.com/madmuzz05/be-enyoblos/service/module/candidate/usecase"
+	certificateHandler "github.com/madmuzz05/be-enyoblos/service/module/certificate/handler"
+	certificateRepository "github.com/madmuzz05/be-enyoblos/service/module/certificate/repository"
+	certificateUsecase "github.com/madmuzz05/be-enyoblos/service/module/certificate/usecase"
+	contestHandler "github.com/madmuzz05/be-enyoblos/service/module/contest/handler"
+	contestRepository "github.com/madmuzz05/be-enyoblos/service/module/contest/repository"
+	contestUsecase "github.com/madmuzz05/be-enyoblos/service/module/contest/usecase"
+	delegationHandler "github.com/madmuzz05/be-enyoblos/service/module/delegation/handler"
+	delegationRepository "github.com/madmuzz05/be-enyoblos/service/module/delegation/repository"
+	delegationUsecase "github.com/madmuzz05/be-enyoblos/service/module/delegation/usecase"
+	disputeHandler "github.com/madmuzz05/be-enyoblos/service/module/dispute/handler"
+	disputeRepository "github.com/madmuzz05/be-enyoblos/service/module/dispute/repository"
+	disputeUsecase "github.com/madmuzz05/be-enyoblos/service/module/dispute/usecase"
+	electionHandler "github.com/madmuzz05/be-enyoblos/service/module/election/handler"
+	electionRepository "github.com/madmuzz05/be-enyoblos/service/module/election/repository"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	kioskHandler "github.com/madmuzz05/be-enyoblos/service/module/kiosk/handler"
+	kioskRepository "github.com/madmuzz05/be-enyoblos/service/module/kiosk/repository"
+	kioskUsecase "github.com/madmuzz05/be-enyoblos/service/module/kiosk/usecase"
+	nominationHandler "github.com/madmuzz05/be-enyoblos/service/module/nomination/handler"
+	nominationRepository "github.com/madmuzz05/be-enyoblos/service/module/nomination/repository"
+	nominationUsecase "github.com/madmuzz05/be-enyoblos/service/module/nomination/usecase"
+	notificationHandler "github.com/madmuzz05/be-enyoblos/service/module/notification/handler"
+	notificationRepository "github.com/madmuzz05/be-enyoblos/service/module/notification/repository"
+	notificationUsecase "github.com/madmuzz05/be-enyoblos/service/module/notification/usecase"
+	observerHandler "github.com/madmuzz05/be-enyoblos/service/module/observer/handler"
+	observerRepository "github.com/madmuzz05/be-enyoblos/service/module/observer/repository"
+	observerUsecase "github.com/madmuzz05/be-enyoblos/service/module/observer/usecase"
 	"github.com/madmuzz05/be-enyoblos/service/module/organization/handler"
 	"github.com/madmuzz05/be-enyoblos/service/module/organization/repository"
 	"github.com/madmuzz05/be-enyoblos/service/module/organization/usecase"
+	roleRepository "github.com/madmuzz05/be-enyoblos/service/module/role/repository"
+	roleUsecase "github.com/madmuzz05/be-enyoblos/service/module/role/usecase"
+	runoffHandler "github.com/madmuzz05/be-enyoblos/service/module/runoff/handler"
+	runoffRepository "github.com/madmuzz05/be-enyoblos/service/module/runoff/repository"
+	runoffUsecase "github.com/madmuzz05/be-enyoblos/service/module/runoff/usecase"
+	trusteeHandler "github.com/madmuzz05/be-enyoblos/service/module/trustee/handler"
+	trusteeRepository "github.com/madmuzz05/be-enyoblos/service/module/trustee/repository"
+	trusteeUsecase "github.com/madmuzz05/be-enyoblos/service/module/trustee/usecase"
 	userRepository "github.com/madmuzz05/be-enyoblos/service/module/user/repository"
 	userUsecase "github.com/madmuzz05/be-enyoblos/service/module/user/usecase"
+	voterRollHandler "github.com/madmuzz05/be-enyoblos/service/module/voterroll/handler"
+	voterRollRepository "github.com/madmuzz05/be-enyoblos/service/module/voterroll/repository"
+	voterRollUsecase "github.com/madmuzz05/be-enyoblos/service/module/voterroll/usecase"
+	votingCodeHandler "github.com/madmuzz05/be-enyoblos/service/module/votingcode/handler"
+	votingCodeRepository "github.com/madmuzz05/be-enyoblos/service/module/votingcode/repository"
+	votingCodeUsecase "github.com/madmuzz05/be-enyoblos/service/module/votingcode/usecase"
 )
 
 func SetupRoutes(app *fiber.App) *fiber.App {
@@ -31,7 +80,9 @@ func SetupRoutes(app *fiber.App) *fiber.App {
 	return app
 }
 
-func InitRoutes(app *fiber.App, db *database.MainDB, redisDb *redisdb.RedisClient) *fiber.App {
+// InitRoutes - Rangkai seluruh module dan daftarkan route-nya. Usecase election ikut dikembalikan
+// supaya scheduler memakai instance yang sama, tanpa merangkai ulang dependency-nya sendiri
+func InitRoutes(app *fiber.App, db *database.MainDB, redisDb *redisdb.RedisClient) (*fiber.App, electionUsecase.IElectionUsecase) {
 	router := SetupRoutes(app)
 	api := router.Group("/api/v1")
 
@@ -44,13 +95,108 @@ func InitRoutes(app *fiber.App, db *database.MainDB, redisDb *redisdb.RedisClien
 	userRepo := userRepository.InitUserRepository(db)
 	userUC := userUsecase.InitUserUsecase(userRepo, orgUsecase, redisDb, db)
 
+	// Initialize Notification
+	notificationRepo := notificationRepository.InitNotificationRepository(db)
+	notificationUC := notificationUsecase.InitNotificationUsecase(notificationRepo)
+	notificationHdl := notificationHandler.InitNotificationHandler(notificationUC)
+
+	// Initialize Role
+	roleRepo := roleRepository.InitRoleRepository(db)
+	roleUC := roleUsecase.InitRoleUsecase(roleRepo)
+
 	// Initialize Auth
-	authUC := authUsecase.InitAuthUsecase(redisDb, userUC)
+	authUC := authUsecase.InitAuthUsecase(redisDb, userUC, roleUC)
 	authHdl := authHandler.InitAuthHandler(authUC)
 
+	// Initialize Election
+	electionRepo := electionRepository.InitElectionRepository(db)
+	electionUC := electionUsecase.InitElectionUsecase(electionRepo, orgUsecase, roleUC, redisDb, db)
+	electionHdl := electionHandler.InitElectionHandler(electionUC)
+
+	// Initialize Observer
+	observerRepo := observerRepository.InitObserverRepository(db)
+	observerUC := observerUsecase.InitObserverUsecase(observerRepo, electionUC, userUC, db)
+	observerHdl := observerHandler.InitObserverHandler(observerUC)
+
+	// Initialize Contest
+	contestRepo := contestRepository.InitContestRepository(db)
+	contestUC := contestUsecase.InitContestUsecase(contestRepo, electionUC, db)
+	contestHdl := contestHandler.InitContestHandler(contestUC)
+
+	// Initialize Candidate
+	candidateRepo := candidateRepository.InitCandidateRepository(db)
+	candidateUC := candidateUsecase.InitCandidateUsecase(candidateRepo, electionUC, contestUC, db)
+	candidateHdl := candidateHandler.InitCandidateHandler(candidateUC)
+
+	// Initialize Nomination
+	nominationRepo := nominationRepository.InitNominationRepository(db)
+	nominationUC := nominationUsecase.InitNominationUsecase(nominationRepo, electionUC, candidateUC, userUC, notificationUC, db)
+	nominationHdl := nominationHandler.InitNominationHandler(nominationUC)
+
+	// Initialize Voter Roll
+	voterRollRepo := voterRollRepository.InitVoterRollRepository(db)
+	voterRollUC := voterRollUsecase.InitVoterRollUsecase(voterRollRepo, electionUC, orgUsecase, db)
+	voterRollHdl := voterRollHandler.InitVoterRollHandler(voterRollUC)
+
+	// Initialize Dispute
+	disputeRepo := disputeRepository.InitDisputeRepository(db)
+	disputeUC := disputeUsecase.InitDisputeUsecase(disputeRepo, electionUC, voterRollUC, nominationUC, notificationUC, db)
+	disputeHdl := disputeHandler.InitDisputeHandler(disputeUC)
+
+	// Initialize Delegation
+	delegationRepo := delegationRepository.InitDelegationRepository(db)
+	delegationUC := delegationUsecase.InitDelegationUsecase(delegationRepo, electionUC, voterRollUC, db)
+	delegationHdl := delegationHandler.InitDelegationHandler(delegationUC)
+
+	// Initialize Trustee
+	trusteeRepo := trusteeRepository.InitTrusteeRepository(db)
+	trusteeUC := trusteeUsecase.InitTrusteeUsecase(trusteeRepo, electionUC, userUC, db)
+	trusteeHdl := trusteeHandler.InitTrusteeHandler(trusteeUC)
+
+	// Initialize Voting Code
+	votingCodeRepo := votingCodeRepository.InitVotingCodeRepository(db)
+	votingCodeUC := votingCodeUsecase.InitVotingCodeUsecase(votingCodeRepo, electionUC, db)
+	votingCodeHdl := votingCodeHandler.InitVotingCodeHandler(votingCodeUC)
+
+	// Initialize Kiosk
+	kioskRepo := kioskRepository.InitKioskRepository(db)
+	kioskUC := kioskUsecase.InitKioskUsecase(kioskRepo, electionUC, voterRollUC, userUC, db)
+	kioskHdl := kioskHandler.InitKioskHandler(kioskUC)
+
+	// Initialize Ballot
+	ballotRepo := ballotRepository.InitBallotRepository(db)
+	ballotUC := ballotUsecase.InitBallotUsecase(ballotRepo, electionUC, candidateUC, contestUC, voterRollUC, votingCodeUC, trusteeUC, delegationUC, kioskUC, redisDb, db)
+	ballotHdl := ballotHandler.InitBallotHandler(ballotUC)
+
+	// Initialize Certificate, tanpa CERTIFICATE_SIGNING_KEY berita acara tidak bisa diterbitkan
+	certificateSigner, _ := signer.FromSeed(config.AppConfig.CertificateKey)
+	certificateRepo := certificateRepository.InitCertificateRepository(db)
+	certificateUC := certificateUsecase.InitCertificateUsecase(certificateRepo, electionUC, orgUsecase, ballotUC, certificateSigner, db)
+	certificateHdl := certificateHandler.InitCertificateHandler(certificateUC)
+
+	// Initialize Runoff
+	runoffRepo := runoffRepository.InitRunoffRepository(db)
+	runoffUC := runoffUsecase.InitRunoffUsecase(runoffRepo, electionUC, ballotUC, db)
+	runoffHdl := runoffHandler.InitRunoffHandler(runoffUC)
+
 	InitAuthRoutes(api, authHdl, redisDb).Routes()
+	InitNotificationRoutes(api, notificationHdl, redisDb).Routes()
 	InitOrganizationRoutes(api, orgHandler, redisDb).Routes()
+	InitElectionRoutes(api, electionHdl, redisDb).Routes()
+	InitObserverRoutes(api, observerHdl, redisDb).Routes()
+	InitContestRoutes(api, contestHdl, redisDb).Routes()
+	InitCandidateRoutes(api, candidateHdl, redisDb).Routes()
+	InitNominationRoutes(api, nominationHdl, redisDb).Routes()
+	InitVoterRollRoutes(api, voterRollHdl, redisDb).Routes()
+	InitDelegationRoutes(api, delegationHdl, redisDb).Routes()
+	InitTrusteeRoutes(api, trusteeHdl, redisDb).Routes()
+	InitVotingCodeRoutes(api, votingCodeHdl, redisDb).Routes()
+	InitKioskRoutes(api, kioskHdl, redisDb).Routes()
+	InitBallotRoutes(api, ballotHdl, redisDb).Routes()
+	InitCertificateRoutes(api, certificateHdl, redisDb).Routes()
+	InitDisputeRoutes(api, disputeHdl, redisDb).Routes()
+	InitRunoffRoutes(api, runoffHdl, redisDb).Routes()
 	// define your routes here
 
-	return router
+	return router, electionUC
 }
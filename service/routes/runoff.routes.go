@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/runoff/handler"
+)
+
+type runoffRoutes struct {
+	Handler     *handler.RunoffHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitRunoffRoutes(router fiber.Router, runoffHandler *handler.RunoffHandler, redis *redisdb.RedisClient) *runoffRoutes {
+	return &runoffRoutes{
+		Handler:     runoffHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *runoffRoutes) Routes() {
+	router := r.Router
+	runoff := router.Group("/elections/:id/runoffs")
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/runoffs - Runoff elections created from this election's tied contests
+	runoff.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetRunoffs))
+
+	// POST /elections/:id/runoffs - Create a draft runoff election with the tied candidates and the same voter roll (admin organization)
+	runoff.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.CreateRunoff))
+}
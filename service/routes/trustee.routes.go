@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/trustee/handler"
+)
+
+type trusteeRoutes struct {
+	Handler     *handler.TrusteeHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitTrusteeRoutes(router fiber.Router, trusteeHandler *handler.TrusteeHandler, redis *redisdb.RedisClient) *trusteeRoutes {
+	return &trusteeRoutes{
+		Handler:     trusteeHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *trusteeRoutes) Routes() {
+	router := r.Router
+	election := router.Group("/elections/:id")
+
+	// GET /elections/:id/key - Election key contributions, threshold, ceremony and decryption status (public)
+	election.Get("/key", r.Handler.GetElectionKey)
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// POST /elections/:id/key-ceremony - Start the key ceremony with a decryption threshold (admin organization)
+	election.Post("/key-ceremony", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RunKeyCeremony))
+
+	// POST /elections/:id/decrypt - Reconstruct the contribution keys from submitted shares (admin organization)
+	election.Post("/decrypt", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DecryptElection))
+
+	trustee := election.Group("/trustees")
+
+	// PUT /elections/:id/trustees/me/public-key - Register own trustee public key (trustee)
+	trustee.Put("/me/public-key", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.RegisterPublicKey))
+
+	// GET /elections/:id/trustees/me/contribution - Recipients for own key contribution shares (trustee)
+	trustee.Get("/me/contribution", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetContributionTargets))
+
+	// POST /elections/:id/trustees/me/contribution - Submit own key contribution and its encrypted shares (trustee)
+	trustee.Post("/me/contribution", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.SubmitContribution))
+
+	// GET /elections/:id/trustees/me/share - Own encrypted shares (trustee)
+	trustee.Get("/me/share", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetMyShare))
+
+	// POST /elections/:id/trustees/me/share - Submit own decrypted shares after close (trustee)
+	trustee.Post("/me/share", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.SubmitShare))
+
+	// GET /elections/:id/trustees - List trustees (admin organization)
+	trustee.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetTrustees))
+
+	// POST /elections/:id/trustees - Appoint a trustee (admin organization)
+	trustee.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.AddTrustee))
+
+	// DELETE /elections/:id/trustees/:trustee_id - Remove a trustee (admin organization)
+	trustee.Delete("/:trustee_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteTrustee))
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/voterroll/handler"
+)
+
+type voterRollRoutes struct {
+	Handler     *handler.VoterRollHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitVoterRollRoutes(router fiber.Router, voterRollHandler *handler.VoterRollHandler, redis *redisdb.RedisClient) *voterRollRoutes {
+	return &voterRollRoutes{
+		Handler:     voterRollHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *voterRollRoutes) Routes() {
+	router := r.Router
+	voterRoll := router.Group("/elections/:id/voter-rolls")
+
+	// ============ Protected Routes (requires JWT, admin organization) ============
+
+	// GET /elections/:id/voter-rolls - Get voter roll (DPT) of an election
+	voterRoll.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetVoterRolls))
+
+	// POST /elections/:id/voter-rolls/members - Add organization members, optionally filtered (supports dry run)
+	voterRoll.Post("/members", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.ImportMembers))
+
+	// POST /elections/:id/voter-rolls/csv - Add voters from a CSV of emails / member numbers (supports dry run)
+	voterRoll.Post("/csv", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.ImportCSV))
+
+	// GET /elections/:id/voter-rolls/rules - Get the eligibility rules stored with the election
+	voterRoll.Get("/rules", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetEligibilityRules))
+
+	// PUT /elections/:id/voter-rolls/rules - Save eligibility rules: min age, roles, child organizations, registration cutoff (before the election opens)
+	voterRoll.Put("/rules", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UpdateEligibilityRules))
+
+	// POST /elections/:id/voter-rolls/rules/evaluate - Evaluate the rules and apply the diff to the roll (supports dry run preview)
+	voterRoll.Post("/rules/evaluate", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.EvaluateEligibilityRules))
+
+	// PUT /elections/:id/voter-rolls/:voter_roll_id/weight - Set a voter's vote weight (before the election opens)
+	voterRoll.Put("/:voter_roll_id/weight", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.UpdateVoterRollWeight))
+
+	// DELETE /elections/:id/voter-rolls/:voter_roll_id - Remove a voter from the roll (before the election opens)
+	voterRoll.Delete("/:voter_roll_id", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.DeleteVoterRoll))
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/middleware"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	"github.com/madmuzz05/be-enyoblos/service/module/votingcode/handler"
+)
+
+type votingCodeRoutes struct {
+	Handler     *handler.VotingCodeHandler
+	Router      fiber.Router
+	RedisClient *redisdb.RedisClient
+}
+
+func InitVotingCodeRoutes(router fiber.Router, votingCodeHandler *handler.VotingCodeHandler, redis *redisdb.RedisClient) *votingCodeRoutes {
+	return &votingCodeRoutes{
+		Handler:     votingCodeHandler,
+		Router:      router,
+		RedisClient: redis,
+	}
+}
+
+func (r *votingCodeRoutes) Routes() {
+	router := r.Router
+	votingCode := router.Group("/elections/:id/voting-codes")
+
+	// POST /elections/:id/voting-codes/exchange - Exchange a voting code for a short-lived ballot token (public)
+	votingCode.Post("/exchange", r.Handler.ExchangeVotingCode)
+
+	// ============ Protected Routes (requires JWT) ============
+
+	// GET /elections/:id/voting-codes - Voting code status per voter roll entry (admin organization)
+	votingCode.Get("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GetVotingCodes))
+
+	// POST /elections/:id/voting-codes - Generate voting codes as a printable CSV (admin organization)
+	votingCode.Post("/", middleware.JWTHS256Middleware(r.RedisClient, r.Handler.GenerateVotingCodes))
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/madmuzz05/be-enyoblos/package/redisdb"
+	electionUsecase "github.com/madmuzz05/be-enyoblos/service/module/election/usecase"
+	"github.com/rs/zerolog/log"
+	"github.com/valyala/fasthttp"
+)
+
+// electionLockKey - lock redis supaya hanya satu instance yang memindahkan status election per putaran
+const electionLockKey = "scheduler:election"
+
+// defaultInterval - jeda antar putaran jika SCHEDULER_INTERVAL tidak diisi
+const defaultInterval = 30 * time.Second
+
+// ElectionScheduler - Buka dan tutup election otomatis sesuai start_at / end_at
+type ElectionScheduler struct {
+	app         *fiber.App
+	electionUse electionUsecase.IElectionUsecase
+	redisClient *redisdb.RedisClient
+	interval    time.Duration
+}
+
+// InitElectionScheduler - electionUse adalah usecase yang sama dengan yang dirangkai routes.InitRoutes
+func InitElectionScheduler(app *fiber.App, electionUse electionUsecase.IElectionUsecase, redisDb *redisdb.RedisClient, intervalSeconds int) *ElectionScheduler {
+	interval := time.Duration(intervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return &ElectionScheduler{
+		app:         app,
+		electionUse: electionUse,
+		redisClient: redisDb,
+		interval:    interval,
+	}
+}
+
+// Start - Jalankan scheduler sampai ctx selesai. Putaran pertama langsung dijalankan
+// supaya transisi yang terlewat selama aplikasi mati segera disusul
+func (s *ElectionScheduler) Start(ctx context.Context) {
+	log.Info().Dur("interval", s.interval).Msg("Election scheduler started")
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Election scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run - Satu putaran: ambil lock, pindahkan status setiap election yang sudah jatuh tempo.
+// Jika putaran melebihi masa lock, instance lain yang ikut jalan tetap aman karena
+// setiap election dikunci dan jadwalnya dicek ulang di database
+func (s *ElectionScheduler) run(ctx context.Context) {
+	token, ok, err := s.redisClient.AcquireLock(electionLockKey, s.interval)
+	if err != nil {
+		log.Error().Err(err).Msg("Election scheduler failed to acquire lock")
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		if err := s.redisClient.ReleaseLock(electionLockKey, token); err != nil {
+			log.Error().Err(err).Msg("Election scheduler failed to release lock")
+		}
+	}()
+
+	// usecase dan repository bekerja dengan fiber.Ctx, buat ctx tanpa request untuk proses background
+	c := s.app.AcquireCtx(&fasthttp.RequestCtx{})
+	defer s.app.ReleaseCtx(c)
+	c.SetContext(ctx)
+
+	elections, sysError := s.electionUse.GetDueElections(c)
+	if sysError != nil {
+		log.Error().Err(sysError.GetError()).Msg("Election scheduler failed to get due elections")
+		return
+	}
+
+	for _, election := range elections {
+		logs, sysError := s.electionUse.ApplyScheduledTransitions(c, election.ID)
+		if sysError != nil {
+			log.Error().Err(sysError.GetError()).Int("election_id", election.ID).Msg("Election scheduler failed to change status")
+			continue
+		}
+		for _, statusLog := range logs {
+			log.Info().Int("election_id", election.ID).Str("from", statusLog.FromStatus).Str("to", statusLog.ToStatus).Msg("Election status changed by scheduler")
+		}
+	}
+}